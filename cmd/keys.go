@@ -0,0 +1,296 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ks "github.com/primevprotocol/mev-commit/pkg/keysigner"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	keysOptionKeystorePath = &cli.StringFlag{
+		Name:    "keystore-path",
+		Usage:   "path to keystore location",
+		EnvVars: []string{"MEV_COMMIT_KEYSTORE_PATH"},
+		Value:   filepath.Join(defaultConfigDir, defaultKeystore),
+	}
+
+	keysOptionKeystorePassword = &cli.StringFlag{
+		Name:     "keystore-password",
+		Usage:    "password used to encrypt the keystore account",
+		EnvVars:  []string{"MEV_COMMIT_KEYSTORE_PASSWORD"},
+		Required: true,
+	}
+
+	keysOptionAddress = &cli.StringFlag{
+		Name:     "address",
+		Usage:    "address of the keystore account",
+		Required: true,
+		Action: func(ctx *cli.Context, s string) error {
+			if !common.IsHexAddress(s) {
+				return fmt.Errorf("invalid address %q", s)
+			}
+			return nil
+		},
+	}
+
+	keysOptionExportPassword = &cli.StringFlag{
+		Name:  "export-password",
+		Usage: "password used to encrypt the exported key, defaults to the keystore password",
+	}
+
+	keysOptionPrivKeyFile = &cli.StringFlag{
+		Name:    "priv-key-file",
+		Usage:   "path to the plaintext private key file",
+		EnvVars: []string{"MEV_COMMIT_PRIVKEY_FILE"},
+		Value:   filepath.Join(defaultConfigDir, defaultKeyFile),
+	}
+
+	keysOptionP2PKeyFile = &cli.StringFlag{
+		Name:    "p2p-key-file",
+		Usage:   "path of the libp2p identity key file to write",
+		EnvVars: []string{"MEV_COMMIT_P2P_KEY_FILE"},
+		Value:   filepath.Join(defaultConfigDir, defaultP2PKey),
+	}
+
+	keysOptionIdentityKeystorePassword = &cli.StringFlag{
+		Name:    "keystore-password",
+		Usage:   "password of the keystore account, the private key file is used if not set",
+		EnvVars: []string{"MEV_COMMIT_KEYSTORE_PASSWORD"},
+	}
+
+	keysOptionIdentityAddress = &cli.StringFlag{
+		Name:  "address",
+		Usage: "address of the keystore account, defaults to the selected account",
+		Action: func(ctx *cli.Context, s string) error {
+			if !common.IsHexAddress(s) {
+				return fmt.Errorf("invalid address %q", s)
+			}
+			return nil
+		},
+	}
+)
+
+var keysCommand = &cli.Command{
+	Name:  "keys",
+	Usage: "Manage the keystore accounts of the node",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "create",
+			Usage:  "Create a new keystore account",
+			Flags:  []cli.Flag{keysOptionKeystorePath, keysOptionKeystorePassword},
+			Action: createKey,
+		},
+		{
+			Name:      "import",
+			Usage:     "Import a hex encoded private key file into the keystore",
+			ArgsUsage: "<key_file>",
+			Flags:     []cli.Flag{keysOptionKeystorePath, keysOptionKeystorePassword},
+			Action:    importKey,
+		},
+		{
+			Name:      "export",
+			Usage:     "Export an encrypted keystore account",
+			ArgsUsage: "<output_file>",
+			Flags: []cli.Flag{
+				keysOptionKeystorePath,
+				keysOptionKeystorePassword,
+				keysOptionAddress,
+				keysOptionExportPassword,
+			},
+			Action: exportKey,
+		},
+		{
+			Name:   "list",
+			Usage:  "List the keystore accounts",
+			Flags:  []cli.Flag{keysOptionKeystorePath},
+			Action: listKeys,
+		},
+		{
+			Name:   "select",
+			Usage:  "Select the keystore account used by the node",
+			Flags:  []cli.Flag{keysOptionKeystorePath, keysOptionAddress},
+			Action: selectKey,
+		},
+		{
+			Name:  "migrate",
+			Usage: "Move a plaintext private key file into the keystore",
+			Flags: []cli.Flag{
+				keysOptionKeystorePath,
+				keysOptionKeystorePassword,
+				keysOptionPrivKeyFile,
+			},
+			Action: migrateKey,
+		},
+		{
+			Name: "p2p-identity",
+			Usage: "Write the ethereum key as the libp2p identity key to keep the " +
+				"peer ID derived from it",
+			Flags: []cli.Flag{
+				keysOptionPrivKeyFile,
+				keysOptionKeystorePath,
+				keysOptionIdentityKeystorePassword,
+				keysOptionIdentityAddress,
+				keysOptionP2PKeyFile,
+			},
+			Action: writeP2PIdentity,
+		},
+	},
+}
+
+func createKey(c *cli.Context) error {
+	keystore, err := ks.OpenKeystore(c.String(keysOptionKeystorePath.Name))
+	if err != nil {
+		return err
+	}
+
+	account, err := keystore.Create(c.String(keysOptionKeystorePassword.Name))
+	if err != nil {
+		return fmt.Errorf("failed to create account: %w", err)
+	}
+
+	fmt.Fprintln(c.App.Writer, "created account", account.Address.Hex())
+	return nil
+}
+
+func importKey(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.Exit("expected the key file as argument", 1)
+	}
+
+	keystore, err := ks.OpenKeystore(c.String(keysOptionKeystorePath.Name))
+	if err != nil {
+		return err
+	}
+
+	account, err := keystore.ImportKeyFile(c.Args().First(), c.String(keysOptionKeystorePassword.Name))
+	if err != nil {
+		return fmt.Errorf("failed to import key: %w", err)
+	}
+
+	fmt.Fprintln(c.App.Writer, "imported account", account.Address.Hex())
+	return nil
+}
+
+func exportKey(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.Exit("expected the output file as argument", 1)
+	}
+
+	keystore, err := ks.OpenKeystore(c.String(keysOptionKeystorePath.Name))
+	if err != nil {
+		return err
+	}
+
+	password := c.String(keysOptionKeystorePassword.Name)
+	exportPassword := password
+	if c.IsSet(keysOptionExportPassword.Name) {
+		exportPassword = c.String(keysOptionExportPassword.Name)
+	}
+
+	keyJSON, err := keystore.Export(
+		common.HexToAddress(c.String(keysOptionAddress.Name)),
+		password,
+		exportPassword,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to export key: %w", err)
+	}
+
+	return os.WriteFile(c.Args().First(), keyJSON, 0600)
+}
+
+func listKeys(c *cli.Context) error {
+	keystore, err := ks.OpenKeystore(c.String(keysOptionKeystorePath.Name))
+	if err != nil {
+		return err
+	}
+
+	// The selected account is only marked if it can be determined.
+	selected, _ := keystore.Selected()
+	for _, account := range keystore.Accounts() {
+		marker := " "
+		if account.Address == selected.Address {
+			marker = "*"
+		}
+		fmt.Fprintln(c.App.Writer, marker, account.Address.Hex(), account.URL.Path)
+	}
+	return nil
+}
+
+func selectKey(c *cli.Context) error {
+	keystore, err := ks.OpenKeystore(c.String(keysOptionKeystorePath.Name))
+	if err != nil {
+		return err
+	}
+
+	address := common.HexToAddress(c.String(keysOptionAddress.Name))
+	if err := keystore.Select(address); err != nil {
+		return fmt.Errorf("failed to select account: %w", err)
+	}
+
+	fmt.Fprintln(c.App.Writer, "selected account", address.Hex())
+	return nil
+}
+
+func migrateKey(c *cli.Context) error {
+	keystore, err := ks.OpenKeystore(c.String(keysOptionKeystorePath.Name))
+	if err != nil {
+		return err
+	}
+
+	account, err := keystore.MigrateKeyFile(
+		c.String(keysOptionPrivKeyFile.Name),
+		c.String(keysOptionKeystorePassword.Name),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to migrate key file: %w", err)
+	}
+
+	fmt.Fprintln(c.App.Writer, "migrated account", account.Address.Hex(), "to", keystore.Path())
+	return nil
+}
+
+func writeP2PIdentity(c *cli.Context) error {
+	var (
+		signer ks.KeySigner
+		err    error
+	)
+	if c.IsSet(keysOptionIdentityKeystorePassword.Name) {
+		signer, err = ks.NewKeystoreSigner(
+			c.String(keysOptionKeystorePath.Name),
+			c.String(keysOptionIdentityKeystorePassword.Name),
+			common.HexToAddress(c.String(keysOptionIdentityAddress.Name)),
+			false,
+		)
+	} else {
+		signer, err = ks.NewPrivateKeySigner(c.String(keysOptionPrivKeyFile.Name), false)
+	}
+	if err != nil {
+		return err
+	}
+
+	ethKey, err := signer.GetPrivateKey()
+	if err != nil {
+		return fmt.Errorf("failed to get private key: %w", err)
+	}
+	defer signer.ZeroPrivateKey(ethKey)
+
+	key, err := libp2p.WriteEthereumIdentityKey(c.String(keysOptionP2PKeyFile.Name), ethKey)
+	if err != nil {
+		return err
+	}
+
+	id, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.App.Writer, "wrote identity key of peer", id, "for account", signer.GetAddress().Hex())
+	return nil
+}
@@ -2,16 +2,23 @@ package main
 
 import (
 	"fmt"
+	"math/big"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	contracts "github.com/primevprotocol/contracts-abi/config"
 	mevcommit "github.com/primevprotocol/mev-commit"
 	ks "github.com/primevprotocol/mev-commit/pkg/keysigner"
 	"github.com/primevprotocol/mev-commit/pkg/node"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/topology"
 	"github.com/primevprotocol/mev-commit/pkg/util"
 	"github.com/urfave/cli/v2"
 	"github.com/urfave/cli/v2/altsrc"
@@ -21,13 +28,18 @@ const (
 	defaultP2PAddr = "0.0.0.0"
 	defaultP2PPort = 13522
 
+	defaultNetworkID = 1
+
 	defaultHTTPPort = 13523
 	defaultRPCPort  = 13524
 
-	defaultConfigDir = "~/.mev-commit"
-	defaultKeyFile   = "key"
-	defaultSecret    = "secret"
-	defaultKeystore  = "keystore"
+	defaultConfigDir   = "~/.mev-commit"
+	defaultKeyFile     = "key"
+	defaultP2PKey      = "p2p_key"
+	defaultSecret      = "secret"
+	defaultKeystore    = "keystore"
+	defaultBlocklist   = "blocklist.json"
+	defaultAddressBook = "address_book.json"
 )
 
 var (
@@ -62,6 +74,13 @@ var (
 		Value:   filepath.Join(defaultConfigDir, defaultKeyFile),
 	})
 
+	optionP2PKeyFile = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "p2p-key-file",
+		Usage:   "path to the libp2p identity key file, created if it does not exist",
+		EnvVars: []string{"MEV_COMMIT_P2P_KEY_FILE"},
+		Value:   filepath.Join(defaultConfigDir, defaultP2PKey),
+	})
+
 	optionKeystorePassword = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:    "keystore-password",
 		Usage:   "use to access keystore",
@@ -75,12 +94,73 @@ var (
 		Value:   filepath.Join(defaultConfigDir, defaultKeystore),
 	})
 
+	optionKeystoreAddress = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "keystore-address",
+		Usage:   "account of the keystore to use, defaults to the selected account",
+		EnvVars: []string{"MEV_COMMIT_KEYSTORE_ADDRESS"},
+		Action: func(ctx *cli.Context, s string) error {
+			if !common.IsHexAddress(s) {
+				return fmt.Errorf("invalid keystore-address %q", s)
+			}
+			return nil
+		},
+	})
+
+	optionCreateKey = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "create-key",
+		Usage:   "create the private key file or keystore account if it does not exist",
+		EnvVars: []string{"MEV_COMMIT_CREATE_KEY"},
+	})
+
+	optionRemoteSignerURL = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-url",
+		Usage:   "url of the remote signer, if set the key is not loaded by the node",
+		EnvVars: []string{"MEV_COMMIT_REMOTE_SIGNER_URL"},
+	})
+
+	optionRemoteSignerAddress = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-address",
+		Usage:   "account of the remote signer to use, required if the signer manages more than one account",
+		EnvVars: []string{"MEV_COMMIT_REMOTE_SIGNER_ADDRESS"},
+		Action: func(ctx *cli.Context, s string) error {
+			if !common.IsHexAddress(s) {
+				return fmt.Errorf("invalid remote-signer-address %q", s)
+			}
+			return nil
+		},
+	})
+
+	optionRemoteSignerCACert = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-ca-certificate",
+		Usage:   "path to the CA certificate used to verify the remote signer",
+		EnvVars: []string{"MEV_COMMIT_REMOTE_SIGNER_CA_CERTIFICATE"},
+	})
+
+	optionRemoteSignerClientCert = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-client-certificate",
+		Usage:   "path to the client TLS certificate presented to the remote signer",
+		EnvVars: []string{"MEV_COMMIT_REMOTE_SIGNER_CLIENT_CERTIFICATE"},
+	})
+
+	optionRemoteSignerClientKey = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-client-key",
+		Usage:   "path to the client TLS private key presented to the remote signer",
+		EnvVars: []string{"MEV_COMMIT_REMOTE_SIGNER_CLIENT_KEY"},
+	})
+
+	optionRemoteSignerTimeout = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "remote-signer-timeout",
+		Usage:   "timeout for requests to the remote signer",
+		EnvVars: []string{"MEV_COMMIT_REMOTE_SIGNER_TIMEOUT"},
+		Value:   10 * time.Second,
+	})
+
 	optionPeerType = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:    "peer-type",
-		Usage:   "peer type to use, options are 'bidder', 'provider' or 'bootnode'",
+		Usage:   "peer type to use, options are 'bidder', 'provider', 'observer' or 'bootnode'",
 		EnvVars: []string{"MEV_COMMIT_PEER_TYPE"},
 		Value:   "bidder",
-		Action:  stringInCheck("peer-type", []string{"bidder", "provider", "bootnode"}),
+		Action:  stringInCheck("peer-type", []string{"bidder", "provider", "observer", "bootnode"}),
 	})
 
 	optionP2PPort = altsrc.NewIntFlag(&cli.IntFlag{
@@ -98,6 +178,48 @@ var (
 		Value:   defaultP2PAddr,
 	})
 
+	optionP2PListenAddrs = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "p2p-listen-addrs",
+		Usage:   "multiaddrs to listen on for p2p connections, e.g. /ip4/0.0.0.0/udp/13522/quic-v1 or /ip6/::/tcp/13522/ws, replaces -p2p-addr and -p2p-port",
+		EnvVars: []string{"MEV_COMMIT_P2P_LISTEN_ADDRS"},
+	})
+
+	optionExternalAddrs = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "external-addrs",
+		Usage:   "multiaddrs under which the node is reachable from other networks, e.g. /dns4/node.example.com/tcp/13522",
+		EnvVars: []string{"MEV_COMMIT_EXTERNAL_ADDRS"},
+	})
+
+	optionRelayService = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "relay-service",
+		Usage:   "relay connections to peers behind a NAT, should only be enabled on publicly reachable nodes",
+		EnvVars: []string{"MEV_COMMIT_RELAY_SERVICE"},
+	})
+
+	optionStaticRelays = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "static-relays",
+		Usage:   "multiaddrs of the relays through which the node is reachable while it is behind a NAT",
+		EnvVars: []string{"MEV_COMMIT_STATIC_RELAYS"},
+	})
+
+	optionHolePunching = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "hole-punching",
+		Usage:   "upgrade relayed connections to direct ones with hole punching",
+		EnvVars: []string{"MEV_COMMIT_HOLE_PUNCHING"},
+	})
+
+	optionBidBroadcast = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "bid-broadcast",
+		Usage:   "broadcast bids to all providers with gossipsub instead of sending them to each provider",
+		EnvVars: []string{"MEV_COMMIT_BID_BROADCAST"},
+	})
+
+	optionGossipCommitments = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "gossip-commitments",
+		Usage:   "gossip the commitments to broadcast bids instead of sending them back to the bidder directly",
+		EnvVars: []string{"MEV_COMMIT_GOSSIP_COMMITMENTS"},
+	})
+
 	optionHTTPPort = altsrc.NewIntFlag(&cli.IntFlag{
 		Name:    "http-port",
 		Usage:   "port to listen for http connections",
@@ -134,6 +256,119 @@ var (
 		EnvVars: []string{"MEV_COMMIT_BOOTNODES"},
 	})
 
+	optionNetworkID = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:    "network-id",
+		Usage:   "ID of the mev-commit network, peers on other networks are rejected during the handshake",
+		EnvVars: []string{"MEV_COMMIT_NETWORK_ID"},
+		Value:   defaultNetworkID,
+	})
+
+	optionPeerPolicyFile = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "peer-policy-file",
+		Usage:   "YAML file with the peer authorization policy, reloaded on SIGHUP",
+		EnvVars: []string{"MEV_COMMIT_PEER_POLICY_FILE"},
+	})
+
+	optionBlocklistFile = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "blocklist-file",
+		Usage:   "path to the file which keeps the blocked peers across restarts",
+		EnvVars: []string{"MEV_COMMIT_BLOCKLIST_FILE"},
+		Value:   filepath.Join(defaultConfigDir, defaultBlocklist),
+	})
+
+	optionAddressBookFile = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "address-book-file",
+		Usage:   "path to the file which keeps the known peers so that they are dialed again after a restart",
+		EnvVars: []string{"MEV_COMMIT_ADDRESS_BOOK_FILE"},
+		Value:   filepath.Join(defaultConfigDir, defaultAddressBook),
+	})
+
+	optionPeerStaleAfter = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "peer-stale-after",
+		Usage:   "duration after which peers which were not seen are removed from the address book",
+		EnvVars: []string{"MEV_COMMIT_PEER_STALE_AFTER"},
+		Value:   7 * 24 * time.Hour,
+	})
+
+	optionStreamRateLimits = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "stream-rate-limits",
+		Usage:   "limits for inbound p2p streams per peer type, overriding the limits of the protocols, as <peer-type>=<streams per second>:<burst>, e.g. bidder=10:20",
+		EnvVars: []string{"MEV_COMMIT_STREAM_RATE_LIMITS"},
+	})
+
+	optionP2PCompression = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "p2p-compression",
+		Usage:   "encodings offered to peers for p2p messages in order of preference, options are 'snappy' and 'zstd'",
+		EnvVars: []string{"MEV_COMMIT_P2P_COMPRESSION"},
+	})
+
+	optionAllowCIDRs = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "allow-cidrs",
+		Usage:   "only dial and accept p2p connections within these CIDR ranges",
+		EnvVars: []string{"MEV_COMMIT_ALLOW_CIDRS"},
+	})
+
+	optionDenyCIDRs = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "deny-cidrs",
+		Usage:   "never dial or accept p2p connections within these CIDR ranges",
+		EnvVars: []string{"MEV_COMMIT_DENY_CIDRS"},
+	})
+
+	optionDenyPrivateAddrs = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "deny-private-addrs",
+		Usage:   "never dial or announce private, loopback and unroutable p2p addresses, recommended on public deployments",
+		EnvVars: []string{"MEV_COMMIT_DENY_PRIVATE_ADDRS"},
+	})
+
+	optionProviderMinStake = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "provider-min-stake",
+		Usage:   "only send bids to providers with at least this stake in wei",
+		EnvVars: []string{"MEV_COMMIT_PROVIDER_MIN_STAKE"},
+	})
+
+	optionProviderMaxLatency = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "provider-max-latency",
+		Usage:   "only send bids to providers with at most this measured latency",
+		EnvVars: []string{"MEV_COMMIT_PROVIDER_MAX_LATENCY"},
+	})
+
+	optionProviderTags = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "provider-tags",
+		Usage:   "only send bids to providers which were assigned all these tags on the debug API",
+		EnvVars: []string{"MEV_COMMIT_PROVIDER_TAGS"},
+	})
+
+	optionMaxProviders = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:    "max-providers",
+		Usage:   "maximum number of providers which receive a bid, the ones with the best reputation are selected",
+		EnvVars: []string{"MEV_COMMIT_MAX_PROVIDERS"},
+	})
+
+	optionMinProviders = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:    "min-providers",
+		Usage:   "minimum number of connected providers, disconnected providers are redialed while the node is below it",
+		EnvVars: []string{"MEV_COMMIT_MIN_PROVIDERS"},
+		Value:   3,
+	})
+
+	optionMinBidders = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:    "min-bidders",
+		Usage:   "minimum number of connected bidders of a provider node, disconnected bidders are redialed while the node is below it",
+		EnvVars: []string{"MEV_COMMIT_MIN_BIDDERS"},
+	})
+
+	optionTracingEndpoint = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "tracing-endpoint",
+		Usage:   "URL of the OTLP gRPC collector to export the traces to, e.g. http://localhost:4317",
+		EnvVars: []string{"MEV_COMMIT_TRACING_ENDPOINT"},
+	})
+
+	optionTracingFile = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "tracing-file",
+		Usage:   "path of the file to append the traces to as JSON",
+		EnvVars: []string{"MEV_COMMIT_TRACING_FILE"},
+	})
+
 	optionSecret = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:    "secret",
 		Usage:   "secret to use for signing",
@@ -230,15 +465,49 @@ func main() {
 		optionConfig,
 		optionPeerType,
 		optionPrivKeyFile,
+		optionP2PKeyFile,
 		optionKeystorePassword,
 		optionKeystorePath,
+		optionKeystoreAddress,
+		optionCreateKey,
+		optionRemoteSignerURL,
+		optionRemoteSignerAddress,
+		optionRemoteSignerCACert,
+		optionRemoteSignerClientCert,
+		optionRemoteSignerClientKey,
+		optionRemoteSignerTimeout,
 		optionP2PPort,
 		optionP2PAddr,
+		optionP2PListenAddrs,
+		optionExternalAddrs,
+		optionRelayService,
+		optionStaticRelays,
+		optionHolePunching,
+		optionBidBroadcast,
+		optionGossipCommitments,
 		optionHTTPPort,
 		optionHTTPAddr,
 		optionRPCPort,
 		optionRPCAddr,
 		optionBootnodes,
+		optionNetworkID,
+		optionPeerPolicyFile,
+		optionBlocklistFile,
+		optionAddressBookFile,
+		optionPeerStaleAfter,
+		optionStreamRateLimits,
+		optionP2PCompression,
+		optionAllowCIDRs,
+		optionDenyCIDRs,
+		optionDenyPrivateAddrs,
+		optionProviderMinStake,
+		optionProviderMaxLatency,
+		optionProviderTags,
+		optionMaxProviders,
+		optionMinProviders,
+		optionMinBidders,
+		optionTracingEndpoint,
+		optionTracingFile,
 		optionSecret,
 		optionLogFmt,
 		optionLogLevel,
@@ -260,6 +529,9 @@ func main() {
 		Flags:   flags,
 		Before:  altsrc.InitInputSourceWithContext(flags, altsrc.NewYamlSourceFromFlagFunc(optionConfig.Name)),
 		Action:  initializeApplication,
+		Commands: []*cli.Command{
+			keysCommand,
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -286,6 +558,54 @@ func verifyKeystorePasswordPresence(c *cli.Context) error {
 	return nil
 }
 
+// parseStreamRateLimits parses limits of the form <peer-type>=<rate>:<burst>.
+func parseStreamRateLimits(values []string) (map[p2p.PeerType]p2p.RateLimit, error) {
+	limits := make(map[p2p.PeerType]p2p.RateLimit)
+	for _, v := range values {
+		name, limit, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid limit %q", v)
+		}
+		peerType := p2p.FromString(name)
+		if peerType == -1 {
+			return nil, fmt.Errorf("unknown peer type %q", name)
+		}
+		rateStr, burstStr, ok := strings.Cut(limit, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid limit %q", v)
+		}
+		rate, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid rate in %q", v)
+		}
+		burst, err := strconv.Atoi(burstStr)
+		if err != nil || burst < 0 {
+			return nil, fmt.Errorf("invalid burst in %q", v)
+		}
+		limits[peerType] = p2p.RateLimit{Rate: rate, Burst: burst}
+	}
+	return limits, nil
+}
+
+// newProviderSelection returns the query which selects the providers
+// receiving the bids.
+func newProviderSelection(c *cli.Context) (topology.Query, error) {
+	q := topology.Query{
+		MaxLatency: c.Duration(optionProviderMaxLatency.Name),
+		Tags:       c.StringSlice(optionProviderTags.Name),
+		Limit:      c.Int(optionMaxProviders.Name),
+		SortBy:     topology.SortByScore,
+	}
+	if v := c.String(optionProviderMinStake.Name); v != "" {
+		minStake, ok := new(big.Int).SetString(v, 10)
+		if !ok || minStake.Sign() < 0 {
+			return topology.Query{}, fmt.Errorf("invalid -%s: %q", optionProviderMinStake.Name, v)
+		}
+		q.MinStake = minStake
+	}
+	return q, nil
+}
+
 // launchNodeWithConfig configures and starts the p2p node based on the CLI context.
 func launchNodeWithConfig(c *cli.Context) error {
 	logger, err := util.NewLogger(
@@ -317,12 +637,31 @@ func launchNodeWithConfig(c *cli.Context) error {
 		return fmt.Errorf("both -%s and -%s must be provided to enable TLS", optionServerTLSCert.Name, optionServerTLSPrivateKey.Name)
 	}
 
+	rateLimits, err := parseStreamRateLimits(c.StringSlice(optionStreamRateLimits.Name))
+	if err != nil {
+		return fmt.Errorf("invalid -%s: %w", optionStreamRateLimits.Name, err)
+	}
+
+	providerSelection, err := newProviderSelection(c)
+	if err != nil {
+		return err
+	}
+
 	nd, err := node.NewNode(&node.Options{
+		Version:                  mevcommit.Version(),
 		KeySigner:                keysigner,
+		P2PKeyFile:               c.String(optionP2PKeyFile.Name),
 		Secret:                   c.String(optionSecret.Name),
 		PeerType:                 c.String(optionPeerType.Name),
 		P2PPort:                  c.Int(optionP2PPort.Name),
 		P2PAddr:                  c.String(optionP2PAddr.Name),
+		P2PListenAddrs:           c.StringSlice(optionP2PListenAddrs.Name),
+		ExternalAddrs:            c.StringSlice(optionExternalAddrs.Name),
+		RelayService:             c.Bool(optionRelayService.Name),
+		StaticRelays:             c.StringSlice(optionStaticRelays.Name),
+		HolePunching:             c.Bool(optionHolePunching.Name),
+		BidBroadcast:             c.Bool(optionBidBroadcast.Name),
+		GossipCommitments:        c.Bool(optionGossipCommitments.Name),
 		HTTPAddr:                 httpAddr,
 		RPCAddr:                  rpcAddr,
 		Logger:                   logger,
@@ -334,12 +673,41 @@ func launchNodeWithConfig(c *cli.Context) error {
 		NatAddr:                  natAddr,
 		TLSCertificateFile:       crtFile,
 		TLSPrivateKeyFile:        keyFile,
+		NetworkID:                c.Uint64(optionNetworkID.Name),
+		PeerPolicyFile:           c.String(optionPeerPolicyFile.Name),
+		BlocklistFile:            c.String(optionBlocklistFile.Name),
+		AddressBookFile:          c.String(optionAddressBookFile.Name),
+		PeerStaleAfter:           c.Duration(optionPeerStaleAfter.Name),
+		StreamRateLimits:         rateLimits,
+		P2PCompression:           c.StringSlice(optionP2PCompression.Name),
+		AllowCIDRs:               c.StringSlice(optionAllowCIDRs.Name),
+		DenyCIDRs:                c.StringSlice(optionDenyCIDRs.Name),
+		DenyPrivateAddrs:         c.Bool(optionDenyPrivateAddrs.Name),
+		ProviderSelection:        providerSelection,
+		MinProviders:             c.Int(optionMinProviders.Name),
+		MinBidders:               c.Int(optionMinBidders.Name),
+		TracingEndpoint:          c.String(optionTracingEndpoint.Name),
+		TracingFile:              c.String(optionTracingFile.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("failed starting node: %w", err)
 	}
 
-	<-c.Done()
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+wait:
+	for {
+		select {
+		case <-sighup:
+			if err := nd.ReloadPeerPolicy(); err != nil {
+				logger.Error("failed to reload peer policy", "error", err)
+			}
+		case <-c.Done():
+			break wait
+		}
+	}
 	logger.Info("shutting down...")
 	closed := make(chan struct{})
 
@@ -362,8 +730,23 @@ func launchNodeWithConfig(c *cli.Context) error {
 }
 
 func newKeySigner(c *cli.Context) (ks.KeySigner, error) {
+	if c.IsSet(optionRemoteSignerURL.Name) {
+		return ks.NewRemoteSigner(ks.RemoteSignerOptions{
+			URL:            c.String(optionRemoteSignerURL.Name),
+			Address:        common.HexToAddress(c.String(optionRemoteSignerAddress.Name)),
+			CACertFile:     c.String(optionRemoteSignerCACert.Name),
+			ClientCertFile: c.String(optionRemoteSignerClientCert.Name),
+			ClientKeyFile:  c.String(optionRemoteSignerClientKey.Name),
+			Timeout:        c.Duration(optionRemoteSignerTimeout.Name),
+		})
+	}
 	if c.IsSet(optionKeystorePath.Name) {
-		return ks.NewKeystoreSigner(c.String(optionKeystorePath.Name), c.String(optionKeystorePassword.Name))
+		return ks.NewKeystoreSigner(
+			c.String(optionKeystorePath.Name),
+			c.String(optionKeystorePassword.Name),
+			common.HexToAddress(c.String(optionKeystoreAddress.Name)),
+			c.Bool(optionCreateKey.Name),
+		)
 	}
-	return ks.NewPrivateKeySigner(c.String(optionPrivKeyFile.Name))
+	return ks.NewPrivateKeySigner(c.String(optionPrivKeyFile.Name), c.Bool(optionCreateKey.Name))
 }
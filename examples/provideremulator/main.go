@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/primevprotocol/mev-commit/examples/provideremulator/client"
 	providerapiv1 "github.com/primevprotocol/mev-commit/gen/go/providerapi/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -42,6 +46,9 @@ func main() {
 		&slog.HandlerOptions{Level: level},
 	))
 
+	// The node passes the W3C trace context of every bid.
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
 	providerClient, err := client.NewProviderClient(*serverAddr, logger)
 	if err != nil {
 		logger.Error("failed to create provider client", "error", err)
@@ -63,8 +70,18 @@ func main() {
 				logger.Warn("closed bid stream")
 				return
 			}
-			logger.Info("received new bid", "bid", bid)
-			err := providerClient.SendBidResponse(context.Background(), &providerapiv1.BidResponse{
+			// Spans started from ctx belong to the trace of the bid.
+			ctx := otel.GetTextMapPropagator().Extract(
+				context.Background(),
+				propagation.MapCarrier(bid.TraceContext),
+			)
+			traceID := trace.SpanContextFromContext(ctx).TraceID()
+			logger.Info("received new bid", "bid", bid, "traceID", traceID)
+			if bid.Deadline > 0 && time.Now().UnixMilli() >= bid.Deadline {
+				logger.Warn("bid expired", "bid", bid, "traceID", traceID)
+				continue
+			}
+			err := providerClient.SendBidResponse(ctx, &providerapiv1.BidResponse{
 				BidDigest: bid.BidDigest,
 				Status:    providerapiv1.BidResponse_STATUS_ACCEPTED,
 			})
@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Bidder_SendBid_FullMethodName         = "/bidderapi.v1.Bidder/SendBid"
-	Bidder_PrepayAllowance_FullMethodName = "/bidderapi.v1.Bidder/PrepayAllowance"
-	Bidder_GetAllowance_FullMethodName    = "/bidderapi.v1.Bidder/GetAllowance"
-	Bidder_GetMinAllowance_FullMethodName = "/bidderapi.v1.Bidder/GetMinAllowance"
+	Bidder_SendBid_FullMethodName           = "/bidderapi.v1.Bidder/SendBid"
+	Bidder_PrepayAllowance_FullMethodName   = "/bidderapi.v1.Bidder/PrepayAllowance"
+	Bidder_GetAllowance_FullMethodName      = "/bidderapi.v1.Bidder/GetAllowance"
+	Bidder_GetMinAllowance_FullMethodName   = "/bidderapi.v1.Bidder/GetMinAllowance"
+	Bidder_WithdrawAllowance_FullMethodName = "/bidderapi.v1.Bidder/WithdrawAllowance"
+	Bidder_GetBidderStatus_FullMethodName   = "/bidderapi.v1.Bidder/GetBidderStatus"
 )
 
 // BidderClient is the client API for Bidder service.
@@ -45,6 +47,16 @@ type BidderClient interface {
 	//
 	// GetMinAllowance is called by the bidder to get the minimum allowance required in the bidder registry to make bids.
 	GetMinAllowance(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*PrepayResponse, error)
+	// WithdrawAllowance
+	//
+	// WithdrawAllowance is called by the bidder to withdraw its prepaid allowance from the bidder registry.
+	WithdrawAllowance(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	// GetBidderStatus
+	//
+	// GetBidderStatus is called by the bidder to get its status in the bidder registry. The funds locked
+	// for the commitments the node received for the bids of the bidder, and for the commitments in the
+	// request, are reported along with the free allowance and the pending withdrawal.
+	GetBidderStatus(ctx context.Context, in *BidderStatusRequest, opts ...grpc.CallOption) (*BidderStatusResponse, error)
 }
 
 type bidderClient struct {
@@ -114,6 +126,24 @@ func (c *bidderClient) GetMinAllowance(ctx context.Context, in *EmptyMessage, op
 	return out, nil
 }
 
+func (c *bidderClient) WithdrawAllowance(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, Bidder_WithdrawAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidderClient) GetBidderStatus(ctx context.Context, in *BidderStatusRequest, opts ...grpc.CallOption) (*BidderStatusResponse, error) {
+	out := new(BidderStatusResponse)
+	err := c.cc.Invoke(ctx, Bidder_GetBidderStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BidderServer is the server API for Bidder service.
 // All implementations must embed UnimplementedBidderServer
 // for forward compatibility
@@ -134,6 +164,16 @@ type BidderServer interface {
 	//
 	// GetMinAllowance is called by the bidder to get the minimum allowance required in the bidder registry to make bids.
 	GetMinAllowance(context.Context, *EmptyMessage) (*PrepayResponse, error)
+	// WithdrawAllowance
+	//
+	// WithdrawAllowance is called by the bidder to withdraw its prepaid allowance from the bidder registry.
+	WithdrawAllowance(context.Context, *EmptyMessage) (*WithdrawResponse, error)
+	// GetBidderStatus
+	//
+	// GetBidderStatus is called by the bidder to get its status in the bidder registry. The funds locked
+	// for the commitments the node received for the bids of the bidder, and for the commitments in the
+	// request, are reported along with the free allowance and the pending withdrawal.
+	GetBidderStatus(context.Context, *BidderStatusRequest) (*BidderStatusResponse, error)
 	mustEmbedUnimplementedBidderServer()
 }
 
@@ -153,6 +193,12 @@ func (UnimplementedBidderServer) GetAllowance(context.Context, *EmptyMessage) (*
 func (UnimplementedBidderServer) GetMinAllowance(context.Context, *EmptyMessage) (*PrepayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMinAllowance not implemented")
 }
+func (UnimplementedBidderServer) WithdrawAllowance(context.Context, *EmptyMessage) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllowance not implemented")
+}
+func (UnimplementedBidderServer) GetBidderStatus(context.Context, *BidderStatusRequest) (*BidderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidderStatus not implemented")
+}
 func (UnimplementedBidderServer) mustEmbedUnimplementedBidderServer() {}
 
 // UnsafeBidderServer may be embedded to opt out of forward compatibility for this service.
@@ -241,6 +287,42 @@ func _Bidder_GetMinAllowance_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bidder_WithdrawAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidderServer).WithdrawAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bidder_WithdrawAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidderServer).WithdrawAllowance(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bidder_GetBidderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BidderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidderServer).GetBidderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bidder_GetBidderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidderServer).GetBidderStatus(ctx, req.(*BidderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bidder_ServiceDesc is the grpc.ServiceDesc for Bidder service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -260,6 +342,14 @@ var Bidder_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMinAllowance",
 			Handler:    _Bidder_GetMinAllowance_Handler,
 		},
+		{
+			MethodName: "WithdrawAllowance",
+			Handler:    _Bidder_WithdrawAllowance_Handler,
+		},
+		{
+			MethodName: "GetBidderStatus",
+			Handler:    _Bidder_GetBidderStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: pubsubmsg/v1/pubsubmsg.proto
+
+package pubsubmsgv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PubSubMsg wraps the messages published on the topics. The header carries
+// the trace context of the publisher.
+type PubSubMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header map[string]*structpb.Value `protobuf:"bytes,1,rep,name=header,proto3" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Data   []byte                     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PubSubMsg) Reset() {
+	*x = PubSubMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsubmsg_v1_pubsubmsg_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubSubMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubMsg) ProtoMessage() {}
+
+func (x *PubSubMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsubmsg_v1_pubsubmsg_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubMsg.ProtoReflect.Descriptor instead.
+func (*PubSubMsg) Descriptor() ([]byte, []int) {
+	return file_pubsubmsg_v1_pubsubmsg_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PubSubMsg) GetHeader() map[string]*structpb.Value {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PubSubMsg) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_pubsubmsg_v1_pubsubmsg_proto protoreflect.FileDescriptor
+
+var file_pubsubmsg_v1_pubsubmsg_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
+	0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x09, 0x50,
+	0x75, 0x62, 0x53, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x12, 0x3b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x75, 0x62, 0x73, 0x75,
+	0x62, 0x6d, 0x73, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x4d, 0x73,
+	0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x51, 0x0a, 0x0b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xb9, 0x01, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x2e, 0x76,
+	0x31, 0x42, 0x0e, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x69, 0x6d, 0x65, 0x76, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d,
+	0x65, 0x76, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+	0x2f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x75,
+	0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa,
+	0x02, 0x0c, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0c, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18,
+	0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6d, 0x73, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x50, 0x75, 0x62, 0x73, 0x75,
+	0x62, 0x6d, 0x73, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pubsubmsg_v1_pubsubmsg_proto_rawDescOnce sync.Once
+	file_pubsubmsg_v1_pubsubmsg_proto_rawDescData = file_pubsubmsg_v1_pubsubmsg_proto_rawDesc
+)
+
+func file_pubsubmsg_v1_pubsubmsg_proto_rawDescGZIP() []byte {
+	file_pubsubmsg_v1_pubsubmsg_proto_rawDescOnce.Do(func() {
+		file_pubsubmsg_v1_pubsubmsg_proto_rawDescData = protoimpl.X.CompressGZIP(file_pubsubmsg_v1_pubsubmsg_proto_rawDescData)
+	})
+	return file_pubsubmsg_v1_pubsubmsg_proto_rawDescData
+}
+
+var file_pubsubmsg_v1_pubsubmsg_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pubsubmsg_v1_pubsubmsg_proto_goTypes = []interface{}{
+	(*PubSubMsg)(nil),      // 0: pubsubmsg.v1.PubSubMsg
+	nil,                    // 1: pubsubmsg.v1.PubSubMsg.HeaderEntry
+	(*structpb.Value)(nil), // 2: google.protobuf.Value
+}
+var file_pubsubmsg_v1_pubsubmsg_proto_depIdxs = []int32{
+	1, // 0: pubsubmsg.v1.PubSubMsg.header:type_name -> pubsubmsg.v1.PubSubMsg.HeaderEntry
+	2, // 1: pubsubmsg.v1.PubSubMsg.HeaderEntry.value:type_name -> google.protobuf.Value
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pubsubmsg_v1_pubsubmsg_proto_init() }
+func file_pubsubmsg_v1_pubsubmsg_proto_init() {
+	if File_pubsubmsg_v1_pubsubmsg_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pubsubmsg_v1_pubsubmsg_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubSubMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pubsubmsg_v1_pubsubmsg_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pubsubmsg_v1_pubsubmsg_proto_goTypes,
+		DependencyIndexes: file_pubsubmsg_v1_pubsubmsg_proto_depIdxs,
+		MessageInfos:      file_pubsubmsg_v1_pubsubmsg_proto_msgTypes,
+	}.Build()
+	File_pubsubmsg_v1_pubsubmsg_proto = out.File
+	file_pubsubmsg_v1_pubsubmsg_proto_rawDesc = nil
+	file_pubsubmsg_v1_pubsubmsg_proto_goTypes = nil
+	file_pubsubmsg_v1_pubsubmsg_proto_depIdxs = nil
+}
@@ -1,7 +1,9 @@
 package bidderregistrycontract
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"log/slog"
 	"math/big"
 	"strings"
@@ -21,6 +23,26 @@ var bidderRegistryABI = func() abi.ABI {
 	return abi
 }
 
+// BidState is the state of the funds locked for a bid in the bidder registry.
+type BidState uint8
+
+const (
+	// BidStateUndefined is the state of an unknown commitment.
+	BidStateUndefined BidState = iota
+	// BidStatePreConfirmed is the state of funds locked for a commitment.
+	BidStatePreConfirmed
+	// BidStateWithdrawn is the state of funds that were retrieved or unlocked.
+	BidStateWithdrawn
+)
+
+// BidPayment is the bid payment entry stored in the bidder registry for a
+// commitment.
+type BidPayment struct {
+	Bidder common.Address
+	Amount *big.Int
+	State  BidState
+}
+
 type Interface interface {
 	// PrepayAllowance registers a bidder with the bidder_registry contract.
 	PrepayAllowance(ctx context.Context, amount *big.Int) error
@@ -30,6 +52,15 @@ type Interface interface {
 	GetMinAllowance(ctx context.Context) (*big.Int, error)
 	// CheckBidderRegistred returns true if bidder is registered
 	CheckBidderAllowance(ctx context.Context, address common.Address) bool
+	// WithdrawAllowance withdraws the prepaid allowance of the bidder.
+	WithdrawAllowance(ctx context.Context, address common.Address) error
+	// IsWithdrawalPending returns true if a withdrawal of the allowance of the
+	// bidder was sent and is not confirmed yet.
+	IsWithdrawalPending(address common.Address) (bool, error)
+	// IsBidderRegistered returns true if the bidder has prepaid an allowance.
+	IsBidderRegistered(ctx context.Context, address common.Address) (bool, error)
+	// GetBidPayment returns the funds locked for the commitment with the given digest.
+	GetBidPayment(ctx context.Context, commitmentDigest common.Hash) (*BidPayment, error)
 }
 
 type bidderRegistryContract struct {
@@ -157,3 +188,113 @@ func (r *bidderRegistryContract) CheckBidderAllowance(
 
 	return stake.Cmp(minStake) >= 0
 }
+
+func (r *bidderRegistryContract) WithdrawAllowance(
+	ctx context.Context,
+	address common.Address,
+) error {
+	callData, err := r.bidderRegistryABI.Pack("withdrawPrepaidAmount", address)
+	if err != nil {
+		r.logger.Error("error packing call data", "error", err)
+		return err
+	}
+
+	txnHash, err := r.client.Send(ctx, &evmclient.TxRequest{
+		To:       &r.bidderRegistryContractAddr,
+		CallData: callData,
+	})
+	if err != nil {
+		return err
+	}
+
+	receipt, err := r.client.WaitForReceipt(ctx, txnHash)
+	if err != nil {
+		return err
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		r.logger.Error(
+			"withdraw failed for bidder registry",
+			"txnHash", txnHash,
+			"receipt", receipt,
+		)
+		return fmt.Errorf("withdraw transaction %s failed", txnHash.Hex())
+	}
+
+	r.logger.Info("withdraw successful for bidder registry", "txnHash", txnHash)
+
+	return nil
+}
+
+func (r *bidderRegistryContract) IsWithdrawalPending(address common.Address) (bool, error) {
+	callData, err := r.bidderRegistryABI.Pack("withdrawPrepaidAmount", address)
+	if err != nil {
+		r.logger.Error("error packing call data", "error", err)
+		return false, err
+	}
+
+	for _, txn := range r.client.PendingTxns() {
+		if txn.To != nil && *txn.To == r.bidderRegistryContractAddr && bytes.Equal(txn.CallData, callData) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *bidderRegistryContract) IsBidderRegistered(
+	ctx context.Context,
+	address common.Address,
+) (bool, error) {
+	callData, err := r.bidderRegistryABI.Pack("bidderRegistered", address)
+	if err != nil {
+		r.logger.Error("error packing call data", "error", err)
+		return false, err
+	}
+
+	result, err := r.client.Call(ctx, &evmclient.TxRequest{
+		To:       &r.bidderRegistryContractAddr,
+		CallData: callData,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	results, err := r.bidderRegistryABI.Unpack("bidderRegistered", result)
+	if err != nil {
+		r.logger.Error("error unpacking result", "error", err)
+		return false, err
+	}
+
+	return *abi.ConvertType(results[0], new(bool)).(*bool), nil
+}
+
+func (r *bidderRegistryContract) GetBidPayment(
+	ctx context.Context,
+	commitmentDigest common.Hash,
+) (*BidPayment, error) {
+	callData, err := r.bidderRegistryABI.Pack("BidPayment", commitmentDigest)
+	if err != nil {
+		r.logger.Error("error packing call data", "error", err)
+		return nil, err
+	}
+
+	result, err := r.client.Call(ctx, &evmclient.TxRequest{
+		To:       &r.bidderRegistryContractAddr,
+		CallData: callData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.bidderRegistryABI.Unpack("BidPayment", result)
+	if err != nil {
+		r.logger.Error("error unpacking result", "error", err)
+		return nil, err
+	}
+
+	return &BidPayment{
+		Bidder: *abi.ConvertType(results[0], new(common.Address)).(*common.Address),
+		Amount: new(big.Int).SetUint64(*abi.ConvertType(results[1], new(uint64)).(*uint64)),
+		State:  BidState(*abi.ConvertType(results[2], new(uint8)).(*uint8)),
+	}, nil
+}
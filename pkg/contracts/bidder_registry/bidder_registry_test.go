@@ -195,4 +195,213 @@ func TestBidderRegistryContract(t *testing.T) {
 			t.Fatal("expected bidder to be registered")
 		}
 	})
+
+	t.Run("WithdrawAllowance", func(t *testing.T) {
+		registryContractAddr := common.HexToAddress("abcd")
+		txHash := common.HexToHash("abcdef")
+		address := common.HexToAddress("abcdef")
+
+		expCallData, err := bidder_registrycontract.BidderRegistryABI().Pack("withdrawPrepaidAmount", address)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tc := range []struct {
+			name   string
+			status uint64
+			err    bool
+		}{
+			{name: "success", status: types.ReceiptStatusSuccessful},
+			{name: "failed receipt", status: types.ReceiptStatusFailed, err: true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				mockClient := mockevmclient.New(
+					mockevmclient.WithSendFunc(
+						func(ctx context.Context, req *evmclient.TxRequest) (common.Hash, error) {
+							if req.To.Cmp(registryContractAddr) != 0 {
+								t.Fatalf(
+									"expected to address to be %s, got %s",
+									registryContractAddr.Hex(), req.To.Hex(),
+								)
+							}
+							if !bytes.Equal(req.CallData, expCallData) {
+								t.Fatalf("expected call data to be %x, got %x", expCallData, req.CallData)
+							}
+
+							return txHash, nil
+						},
+					),
+					mockevmclient.WithWaitForReceiptFunc(
+						func(ctx context.Context, txnHash common.Hash) (*types.Receipt, error) {
+							if txnHash != txHash {
+								t.Fatalf(
+									"expected txn hash to be %s, got %s",
+									txHash.Hex(), txnHash.Hex(),
+								)
+							}
+							return &types.Receipt{
+								Status: tc.status,
+							}, nil
+						},
+					),
+				)
+
+				registryContract := bidder_registrycontract.New(
+					registryContractAddr,
+					mockClient,
+					util.NewTestLogger(os.Stdout),
+				)
+
+				err := registryContract.WithdrawAllowance(context.Background(), address)
+				if tc.err && err == nil {
+					t.Fatal("expected error withdrawing allowance")
+				}
+				if !tc.err && err != nil {
+					t.Fatal(err)
+				}
+			})
+		}
+	})
+
+	t.Run("IsWithdrawalPending", func(t *testing.T) {
+		registryContractAddr := common.HexToAddress("abcd")
+		otherContractAddr := common.HexToAddress("dcba")
+		address := common.HexToAddress("abcdef")
+
+		callData, err := bidder_registrycontract.BidderRegistryABI().Pack("withdrawPrepaidAmount", address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		otherCallData, err := bidder_registrycontract.BidderRegistryABI().Pack("withdrawPrepaidAmount", common.HexToAddress("1234"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tc := range []struct {
+			name    string
+			txns    []evmclient.TxnInfo
+			pending bool
+		}{
+			{name: "no pending txns"},
+			{
+				name:    "withdrawal",
+				txns:    []evmclient.TxnInfo{{To: &registryContractAddr, CallData: callData}},
+				pending: true,
+			},
+			{
+				name: "withdrawal of other bidder",
+				txns: []evmclient.TxnInfo{{To: &registryContractAddr, CallData: otherCallData}},
+			},
+			{
+				name: "other contract",
+				txns: []evmclient.TxnInfo{{To: &otherContractAddr, CallData: callData}},
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				mockClient := mockevmclient.New(
+					mockevmclient.WithPendingTxnsFunc(func() []evmclient.TxnInfo {
+						return tc.txns
+					}),
+				)
+
+				registryContract := bidder_registrycontract.New(
+					registryContractAddr,
+					mockClient,
+					util.NewTestLogger(os.Stdout),
+				)
+
+				pending, err := registryContract.IsWithdrawalPending(address)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if pending != tc.pending {
+					t.Fatalf("expected pending to be %t, got %t", tc.pending, pending)
+				}
+			})
+		}
+	})
+
+	t.Run("IsBidderRegistered", func(t *testing.T) {
+		registryContractAddr := common.HexToAddress("abcd")
+		address := common.HexToAddress("abcdef")
+
+		expCallData, err := bidder_registrycontract.BidderRegistryABI().Pack("bidderRegistered", address)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mockClient := mockevmclient.New(
+			mockevmclient.WithCallFunc(
+				func(ctx context.Context, req *evmclient.TxRequest) ([]byte, error) {
+					if !bytes.Equal(req.CallData, expCallData) {
+						t.Fatalf("expected call data to be %x, got %x", expCallData, req.CallData)
+					}
+
+					return big.NewInt(1).FillBytes(make([]byte, 32)), nil
+				},
+			),
+		)
+
+		registryContract := bidder_registrycontract.New(
+			registryContractAddr,
+			mockClient,
+			util.NewTestLogger(os.Stdout),
+		)
+
+		registered, err := registryContract.IsBidderRegistered(context.Background(), address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !registered {
+			t.Fatal("expected bidder to be registered")
+		}
+	})
+
+	t.Run("GetBidPayment", func(t *testing.T) {
+		registryContractAddr := common.HexToAddress("abcd")
+		address := common.HexToAddress("abcdef")
+		digest := common.HexToHash("0x1234")
+		amount := big.NewInt(1000000000)
+
+		expCallData, err := bidder_registrycontract.BidderRegistryABI().Pack("BidPayment", digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mockClient := mockevmclient.New(
+			mockevmclient.WithCallFunc(
+				func(ctx context.Context, req *evmclient.TxRequest) ([]byte, error) {
+					if !bytes.Equal(req.CallData, expCallData) {
+						t.Fatalf("expected call data to be %x, got %x", expCallData, req.CallData)
+					}
+
+					return bidder_registrycontract.BidderRegistryABI().Methods["BidPayment"].Outputs.Pack(
+						address,
+						amount.Uint64(),
+						uint8(bidder_registrycontract.BidStatePreConfirmed),
+					)
+				},
+			),
+		)
+
+		registryContract := bidder_registrycontract.New(
+			registryContractAddr,
+			mockClient,
+			util.NewTestLogger(os.Stdout),
+		)
+
+		payment, err := registryContract.GetBidPayment(context.Background(), digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if payment.Bidder != address {
+			t.Fatalf("expected bidder to be %s, got %s", address.Hex(), payment.Bidder.Hex())
+		}
+		if payment.Amount.Cmp(amount) != 0 {
+			t.Fatalf("expected amount to be %s, got %s", amount.String(), payment.Amount.String())
+		}
+		if payment.State != bidder_registrycontract.BidStatePreConfirmed {
+			t.Fatalf("expected state to be %d, got %d", bidder_registrycontract.BidStatePreConfirmed, payment.State)
+		}
+	})
 }
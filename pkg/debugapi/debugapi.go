@@ -1,12 +1,18 @@
 package debugapi
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
+	"math/big"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/primevprotocol/mev-commit/pkg/apiserver"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/blocklist"
 	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p"
 	"github.com/primevprotocol/mev-commit/pkg/topology"
 )
@@ -17,6 +23,8 @@ type APIServer interface {
 
 type Topology interface {
 	GetPeers(topology.Query) []p2p.Peer
+	PeerDetails(topology.Query) []topology.PeerDetails
+	SetTags(common.Address, []string)
 }
 
 func RegisterAPI(
@@ -35,6 +43,34 @@ func RegisterAPI(
 		"/topology",
 		apiserver.MethodHandler("GET", d.handleTopology),
 	)
+	srv.ChainHandlers(
+		"/topology/peers",
+		apiserver.MethodHandler("GET", d.handlePeers),
+	)
+	srv.ChainHandlers(
+		"/topology/tags",
+		apiserver.MethodHandler("POST", d.handleTags),
+	)
+	srv.ChainHandlers(
+		"/bootnodes",
+		apiserver.MethodHandler("GET", d.handleBootnodes),
+	)
+	srv.ChainHandlers(
+		"/bootnodes/update",
+		apiserver.MethodHandler("POST", d.handleUpdateBootnodes),
+	)
+	srv.ChainHandlers(
+		"/blocklist",
+		apiserver.MethodHandler("GET", d.handleBlocklist),
+	)
+	srv.ChainHandlers(
+		"/blocklist/block",
+		apiserver.MethodHandler("POST", d.handleBlock),
+	)
+	srv.ChainHandlers(
+		"/blocklist/unblock",
+		apiserver.MethodHandler("POST", d.handleUnblock),
+	)
 }
 
 type debugapi struct {
@@ -53,6 +89,7 @@ func (d *debugapi) handleTopology(w http.ResponseWriter, r *http.Request) {
 	logger := d.logger.With("method", "handleTopology")
 	providers := d.topo.GetPeers(topology.Query{Type: p2p.PeerTypeProvider})
 	bidders := d.topo.GetPeers(topology.Query{Type: p2p.PeerTypeBidder})
+	observers := d.topo.GetPeers(topology.Query{Type: p2p.PeerTypeObserver})
 
 	topoResp := topologyResponse{
 		Self:           d.p2p.Self(),
@@ -73,6 +110,13 @@ func (d *debugapi) handleTopology(w http.ResponseWriter, r *http.Request) {
 		}
 		topoResp.ConnectedPeers["bidders"] = connectedBidders
 	}
+	if len(observers) > 0 {
+		connectedObservers := make([]common.Address, len(observers))
+		for idx, observer := range observers {
+			connectedObservers[idx] = observer.EthAddress
+		}
+		topoResp.ConnectedPeers["observers"] = connectedObservers
+	}
 
 	topoResp.BlockedPeers = d.p2p.BlockedPeers()
 
@@ -81,3 +125,251 @@ func (d *debugapi) handleTopology(w http.ResponseWriter, r *http.Request) {
 		logger.Error("error writing response", "err", err)
 	}
 }
+
+// parseQuery parses the topology query from the URL parameters type,
+// protocol, protocol_version, feature, min_score, min_stake, max_latency,
+// min_version, min_connection_age, tag (repeated), sort and limit.
+func parseQuery(r *http.Request) (topology.Query, error) {
+	params := r.URL.Query()
+
+	q := topology.Query{
+		Type:       p2p.FromString(params.Get("type")),
+		Feature:    params.Get("feature"),
+		MinVersion: params.Get("min_version"),
+		Tags:       params["tag"],
+	}
+	if q.Type == -1 {
+		return topology.Query{}, errors.New("type has to be provider, bidder or observer")
+	}
+	if name := params.Get("protocol"); name != "" {
+		q.Protocol = p2p.ProtocolInfo{Name: name, Version: params.Get("protocol_version")}
+	}
+	if v := params.Get("min_score"); v != "" {
+		minScore, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return topology.Query{}, errors.New("invalid min_score")
+		}
+		q.MinScore = &minScore
+	}
+	if v := params.Get("min_stake"); v != "" {
+		minStake, ok := new(big.Int).SetString(v, 10)
+		if !ok {
+			return topology.Query{}, errors.New("invalid min_stake")
+		}
+		q.MinStake = minStake
+	}
+	for name, dur := range map[string]*time.Duration{
+		"max_latency":        &q.MaxLatency,
+		"min_connection_age": &q.MinConnectionAge,
+	} {
+		if v := params.Get(name); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d < 0 {
+				return topology.Query{}, fmt.Errorf("invalid %s", name)
+			}
+			*dur = d
+		}
+	}
+	sortBy, err := topology.ParseSortKey(params.Get("sort"))
+	if err != nil {
+		return topology.Query{}, err
+	}
+	q.SortBy = sortBy
+	if v := params.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			return topology.Query{}, errors.New("invalid limit")
+		}
+		q.Limit = limit
+	}
+	return q, nil
+}
+
+func (d *debugapi) handlePeers(w http.ResponseWriter, r *http.Request) {
+	logger := d.logger.With("method", "handlePeers")
+
+	q, err := parseQuery(r)
+	if err != nil {
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	}
+
+	peers := d.topo.PeerDetails(q)
+	if peers == nil {
+		peers = []topology.PeerDetails{}
+	}
+
+	err = apiserver.WriteResponse(w, http.StatusOK, peers)
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+type tagsRequest struct {
+	// Peer is the ethereum address of the peer.
+	Peer string `json:"peer"`
+	// Tags replace the tags of the peer, an empty list removes them.
+	Tags []string `json:"tags"`
+}
+
+func (d *debugapi) handleTags(w http.ResponseWriter, r *http.Request) {
+	logger := d.logger.With("method", "handleTags")
+
+	req, err := apiserver.BindJSON[tagsRequest](w, r)
+	if err != nil {
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	}
+	if !common.IsHexAddress(req.Peer) {
+		writeError(w, logger, http.StatusBadRequest, errors.New("peer has to be an ethereum address"))
+		return
+	}
+
+	d.topo.SetTags(common.HexToAddress(req.Peer), req.Tags)
+
+	err = apiserver.WriteResponse(w, http.StatusOK, "tags updated")
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+func (d *debugapi) handleBootnodes(w http.ResponseWriter, r *http.Request) {
+	logger := d.logger.With("method", "handleBootnodes")
+
+	err := apiserver.WriteResponse(w, http.StatusOK, d.p2p.Bootnodes())
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+type bootnodesRequest struct {
+	// Bootnodes replace the configured bootnodes, an empty list removes
+	// them.
+	Bootnodes []string `json:"bootnodes"`
+}
+
+func (d *debugapi) handleUpdateBootnodes(w http.ResponseWriter, r *http.Request) {
+	logger := d.logger.With("method", "handleUpdateBootnodes")
+
+	req, err := apiserver.BindJSON[bootnodesRequest](w, r)
+	if err != nil {
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.p2p.SetBootnodes(req.Bootnodes); err != nil {
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	}
+
+	err = apiserver.WriteResponse(w, http.StatusOK, "bootnodes updated")
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+type blocklistResponse struct {
+	BlockedPeers []p2p.BlockedPeerInfo `json:"blocked_peers"`
+	History      []blocklist.Event     `json:"history"`
+}
+
+func (d *debugapi) handleBlocklist(w http.ResponseWriter, r *http.Request) {
+	logger := d.logger.With("method", "handleBlocklist")
+
+	resp := blocklistResponse{
+		BlockedPeers: d.p2p.BlockedPeers(),
+		History:      d.p2p.BlocklistHistory(),
+	}
+
+	err := apiserver.WriteResponse(w, http.StatusOK, resp)
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+type blockRequest struct {
+	// Peer is an ethereum address or a peer ID.
+	Peer   string `json:"peer"`
+	Reason string `json:"reason"`
+	// Duration of the block, e.g. "1h". The peer is blocked forever if it
+	// is empty.
+	Duration string `json:"duration"`
+}
+
+func (d *debugapi) handleBlock(w http.ResponseWriter, r *http.Request) {
+	logger := d.logger.With("method", "handleBlock")
+
+	req, err := apiserver.BindJSON[blockRequest](w, r)
+	if err != nil {
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	}
+
+	var dur time.Duration
+	if req.Duration != "" {
+		dur, err = time.ParseDuration(req.Duration)
+		if err != nil || dur < 0 {
+			writeError(w, logger, http.StatusBadRequest, errors.New("invalid duration"))
+			return
+		}
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "blocked by operator"
+	}
+
+	err = d.p2p.Block(req.Peer, dur, reason)
+	switch {
+	case errors.Is(err, libp2p.ErrInvalidPeer):
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	case err != nil:
+		writeError(w, logger, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = apiserver.WriteResponse(w, http.StatusOK, "peer blocked")
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+type unblockRequest struct {
+	// Peer is an ethereum address or a peer ID.
+	Peer string `json:"peer"`
+}
+
+func (d *debugapi) handleUnblock(w http.ResponseWriter, r *http.Request) {
+	logger := d.logger.With("method", "handleUnblock")
+
+	req, err := apiserver.BindJSON[unblockRequest](w, r)
+	if err != nil {
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	}
+
+	err = d.p2p.Unblock(req.Peer)
+	switch {
+	case errors.Is(err, libp2p.ErrInvalidPeer):
+		writeError(w, logger, http.StatusBadRequest, err)
+		return
+	case errors.Is(err, blocklist.ErrNotFound):
+		writeError(w, logger, http.StatusNotFound, err)
+		return
+	case err != nil:
+		writeError(w, logger, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = apiserver.WriteResponse(w, http.StatusOK, "peer unblocked")
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, logger *slog.Logger, code int, err error) {
+	if werr := apiserver.WriteResponse(w, code, err.Error()); werr != nil {
+		logger.Error("error writing response", "err", werr)
+	}
+}
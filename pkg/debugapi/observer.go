@@ -0,0 +1,74 @@
+package debugapi
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/apiserver"
+	"github.com/primevprotocol/mev-commit/pkg/preconfirmation"
+)
+
+// Observer follows the commitments gossiped in the network.
+type Observer interface {
+	ObservedCommitments(provider *common.Address) []preconfirmation.ObservedCommitment
+	ObservedProviders() []preconfirmation.ObservedProvider
+}
+
+// RegisterObserverAPI registers the endpoints which expose the commitments
+// and providers seen by an observer.
+func RegisterObserverAPI(
+	srv APIServer,
+	observer Observer,
+	logger *slog.Logger,
+) {
+	o := &observerapi{
+		observer: observer,
+		logger:   logger,
+	}
+
+	srv.ChainHandlers(
+		"/observer/commitments",
+		apiserver.MethodHandler("GET", o.handleCommitments),
+	)
+	srv.ChainHandlers(
+		"/observer/providers",
+		apiserver.MethodHandler("GET", o.handleProviders),
+	)
+}
+
+type observerapi struct {
+	observer Observer
+	logger   *slog.Logger
+}
+
+// handleCommitments returns the latest observed commitments, the newest
+// first. The optional provider parameter filters them by provider address.
+func (o *observerapi) handleCommitments(w http.ResponseWriter, r *http.Request) {
+	logger := o.logger.With("method", "handleCommitments")
+
+	var provider *common.Address
+	if v := r.URL.Query().Get("provider"); v != "" {
+		if !common.IsHexAddress(v) {
+			writeError(w, logger, http.StatusBadRequest, errors.New("provider has to be an ethereum address"))
+			return
+		}
+		addr := common.HexToAddress(v)
+		provider = &addr
+	}
+
+	err := apiserver.WriteResponse(w, http.StatusOK, o.observer.ObservedCommitments(provider))
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
+
+func (o *observerapi) handleProviders(w http.ResponseWriter, r *http.Request) {
+	logger := o.logger.With("method", "handleProviders")
+
+	err := apiserver.WriteResponse(w, http.StatusOK, o.observer.ObservedProviders())
+	if err != nil {
+		logger.Error("error writing response", "err", err)
+	}
+}
@@ -2,39 +2,114 @@ package discovery
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	discoverypb "github.com/primevprotocol/mev-commit/gen/go/discovery/v1"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/peerrecord"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/reputation"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 const (
 	ProtocolName    = "discovery"
-	ProtocolVersion = "2.0.0"
-	checkWorkers    = 10
+	ProtocolVersion = "3.0.0"
+	// GetPeersProtocolName is the protocol used to request the connected
+	// providers from a peer.
+	GetPeersProtocolName    = "discovery-getpeers"
+	GetPeersProtocolVersion = "2.0.0"
+
+	checkWorkers     = 10
+	broadcastWorkers = 10
+	broadcastTimeout = 10 * time.Second
+
+	// resyncInterval is the interval at which the peers are requested from
+	// the connected bootnodes and providers, so that peers which were missed
+	// in a broadcast are eventually found.
+	resyncInterval = 5 * time.Minute
+	// dedupWindow is the time during which a peer is not broadcasted again
+	// to the same peer unless its underlay changed.
+	dedupWindow = resyncInterval
+	// maxPeerListSize is the maximum number of peers in a peer list.
+	maxPeerListSize = 500
+	// maxMessageSize limits the size of the messages on the streams, it
+	// fits a peer list of maxPeerListSize peers.
+	maxMessageSize = 1 << 20
+	// announceRate is the number of peers per second a peer may announce on
+	// average, lists of up to maxPeerListSize peers are allowed at once.
+	announceRate = 2
+	// recordTTL is the time for which the timestamp of the newest record of
+	// a peer is kept to reject older records. Records expire by then, so they
+	// can't be replayed once the timestamp is pruned.
+	recordTTL = peerrecord.MaxAge
 )
 
 type P2PService interface {
 	p2p.Streamer
 	Connect(context.Context, []byte) (p2p.Peer, error)
+	Peers() []p2p.Peer
 }
 
 type Topology interface {
 	AddPeers(...p2p.Peer)
 	IsConnected(common.Address) bool
+	PeerInfos(p2p.PeerType, common.Address) []p2p.PeerInfo
+}
+
+// Reporter receives the events which affect the reputation of peers.
+type Reporter interface {
+	Report(common.Address, reputation.Event)
+}
+
+// AddrFilter removes addresses which must not be dialed from announced
+// underlays.
+type AddrFilter interface {
+	FilterUnderlay([]byte) ([]byte, error)
+}
+
+// broadcast collects the peers which are going to be sent to a peer.
+type broadcast struct {
+	peer  p2p.Peer
+	peers map[common.Address]p2p.PeerInfo
+}
+
+type sentPeer struct {
+	underlay string
+	at       time.Time
 }
 
 type Discovery struct {
 	topo       Topology
 	streamer   P2PService
+	reporter   Reporter
+	filter     AddrFilter
 	logger     *slog.Logger
+	metrics    *metrics
 	checkPeers chan *discoverypb.PeerInfo
 	sem        *semaphore.Weighted
 	quit       chan struct{}
+
+	mu sync.Mutex
+	// connecting are the peers which are being dialed.
+	connecting map[common.Address]struct{}
+	// pending are the broadcasts which are not sent yet by target peer.
+	pending map[common.Address]*broadcast
+	// sent are the peers which were sent recently by target peer.
+	sent          map[common.Address]map[common.Address]sentPeer
+	wakeBroadcast chan struct{}
+	broadcastSem  *semaphore.Weighted
+	// records are the timestamps of the newest records by peer.
+	records map[common.Address]int64
+	// announceLimiters limit the peers announced by each peer.
+	announceLimiters map[common.Address]*rate.Limiter
+	now              func() time.Time
 }
 
 func New(
@@ -43,27 +118,76 @@ func New(
 	logger *slog.Logger,
 ) *Discovery {
 	d := &Discovery{
-		topo:       topo,
-		streamer:   streamer,
-		logger:     logger.With("protocol", ProtocolName),
-		sem:        semaphore.NewWeighted(checkWorkers),
-		checkPeers: make(chan *discoverypb.PeerInfo),
-		quit:       make(chan struct{}),
+		topo:             topo,
+		streamer:         streamer,
+		logger:           logger.With("protocol", ProtocolName),
+		metrics:          newMetrics(),
+		sem:              semaphore.NewWeighted(checkWorkers),
+		checkPeers:       make(chan *discoverypb.PeerInfo),
+		quit:             make(chan struct{}),
+		connecting:       make(map[common.Address]struct{}),
+		pending:          make(map[common.Address]*broadcast),
+		sent:             make(map[common.Address]map[common.Address]sentPeer),
+		wakeBroadcast:    make(chan struct{}, 1),
+		broadcastSem:     semaphore.NewWeighted(broadcastWorkers),
+		records:          make(map[common.Address]int64),
+		announceLimiters: make(map[common.Address]*rate.Limiter),
+		now:              time.Now,
 	}
 	go d.checkAndAddPeers()
+	go d.broadcastLoop()
+	go d.resyncLoop()
 	return d
 }
 
+func (d *Discovery) SetReporter(r Reporter) {
+	d.reporter = r
+}
+
+func (d *Discovery) SetAddrFilter(f AddrFilter) {
+	d.filter = f
+}
+
+func (d *Discovery) report(peer p2p.Peer, ev reputation.Event) {
+	if d.reporter != nil {
+		d.reporter.Report(peer.EthAddress, ev)
+	}
+}
+
 func (d *Discovery) peerListStream() p2p.StreamDesc {
 	return p2p.StreamDesc{
 		Name:    ProtocolName,
 		Version: ProtocolVersion,
 		Handler: d.handlePeersList,
+		// Peer lists are only sent when peers connect, bootnodes send them
+		// more often as all peers connect to them first.
+		RateLimits: map[p2p.PeerType]p2p.RateLimit{
+			p2p.PeerTypeBootnode: {Rate: 5, Burst: 50},
+			p2p.PeerTypeProvider: {Rate: 1, Burst: 10},
+			p2p.PeerTypeBidder:   {Rate: 1, Burst: 10},
+			p2p.PeerTypeObserver: {Rate: 1, Burst: 10},
+		},
+		MaxMessageSize: maxMessageSize,
+	}
+}
+
+func (d *Discovery) getPeersStream() p2p.StreamDesc {
+	return p2p.StreamDesc{
+		Name:    GetPeersProtocolName,
+		Version: GetPeersProtocolVersion,
+		Handler: d.handleGetPeers,
+		// Peers only request the peer list periodically.
+		RateLimits: map[p2p.PeerType]p2p.RateLimit{
+			p2p.PeerTypeProvider: {Rate: 0.1, Burst: 5},
+			p2p.PeerTypeBidder:   {Rate: 0.1, Burst: 5},
+			p2p.PeerTypeObserver: {Rate: 0.1, Burst: 5},
+		},
+		MaxMessageSize: maxMessageSize,
 	}
 }
 
 func (d *Discovery) Streams() []p2p.StreamDesc {
-	return []p2p.StreamDesc{d.peerListStream()}
+	return []p2p.StreamDesc{d.peerListStream(), d.getPeersStream()}
 }
 
 func (d *Discovery) handlePeersList(ctx context.Context, peer p2p.Peer, s p2p.Stream) error {
@@ -71,30 +195,255 @@ func (d *Discovery) handlePeersList(ctx context.Context, peer p2p.Peer, s p2p.St
 	err := s.ReadMsg(ctx, peers)
 	if err != nil {
 		d.logger.Error("failed to read peers list", "err", err, "from_peer", peer)
+		d.report(peer, reputation.EventMalformedMessage)
 		return status.Errorf(codes.InvalidArgument, "failed to read peers list: %v", err)
 	}
 
-	for _, p := range peers.Peers {
-		if d.topo.IsConnected(common.BytesToAddress(p.EthAddress)) {
+	return d.addPeers(ctx, peer, peers.Peers)
+}
+
+func (d *Discovery) handleGetPeers(ctx context.Context, peer p2p.Peer, s p2p.Stream) error {
+	req := new(discoverypb.GetPeersRequest)
+	err := s.ReadMsg(ctx, req)
+	if err != nil {
+		d.logger.Error("failed to read get peers request", "err", err, "from_peer", peer)
+		d.report(peer, reputation.EventMalformedMessage)
+		return status.Errorf(codes.InvalidArgument, "failed to read get peers request: %v", err)
+	}
+
+	limit := maxPeerListSize
+	if req.Limit > 0 && int(req.Limit) < limit {
+		limit = int(req.Limit)
+	}
+	peers := d.topo.PeerInfos(p2p.PeerTypeProvider, peer.EthAddress)
+	if len(peers) > limit {
+		peers = peers[:limit]
+	}
+
+	if err := s.WriteMsg(ctx, toPeerList(peers)); err != nil {
+		d.logger.Error("failed to write peers list", "err", err, "to_peer", peer)
+		return err
+	}
+
+	d.metrics.GetPeersRequestsCount.Inc()
+	d.metrics.SentPeersCount.Add(float64(len(peers)))
+	d.logger.Debug("answered get peers request", "peers", len(peers), "to_peer", peer)
+	return nil
+}
+
+// addPeers verifies the peer records received from a peer and queues the
+// peers which are not connected to be dialed.
+func (d *Discovery) addPeers(ctx context.Context, from p2p.Peer, peers []*discoverypb.PeerInfo) error {
+	if len(peers) > maxPeerListSize {
+		d.logger.Error("peers list too long", "peers", len(peers), "from_peer", from)
+		d.report(from, reputation.EventMalformedMessage)
+		return status.Errorf(codes.InvalidArgument, "peers list too long")
+	}
+	if !d.allowAnnouncement(from, len(peers)) {
+		d.metrics.RateLimitedListsCount.Inc()
+		d.logger.Warn("peers list over the announcement rate", "peers", len(peers), "from_peer", from)
+		return status.Errorf(codes.ResourceExhausted, "too many announced peers")
+	}
+
+	d.metrics.ReceivedPeersCount.Add(float64(len(peers)))
+
+	now := d.now()
+	for _, p := range peers {
+		if len(p.EthAddress) != common.AddressLength || len(p.Underlay) == 0 {
+			d.logger.Error("invalid peer in peers list", "from_peer", from)
+			d.report(from, reputation.EventMalformedMessage)
+			return status.Errorf(codes.InvalidArgument, "invalid peer in peers list")
+		}
+		record := p2p.PeerInfo{
+			EthAddress: common.BytesToAddress(p.EthAddress),
+			Underlay:   p.Underlay,
+			Timestamp:  p.Timestamp,
+			Signature:  p.Signature,
+		}
+		// Peers can only relay records, they can't forge them. Expired
+		// records may still be relayed by peers which are connected to the
+		// peer for long, they are ignored.
+		_, err := peerrecord.Verify(record, now)
+		if errors.Is(err, peerrecord.ErrExpired) {
+			d.logger.Debug("ignoring expired peer record", "peer", record.EthAddress, "from_peer", from)
+			continue
+		}
+		if err != nil {
+			d.metrics.InvalidRecordsCount.Inc()
+			d.logger.Error("invalid peer record", "err", err, "peer", record.EthAddress, "from_peer", from)
+			d.report(from, reputation.EventInvalidSignature)
+			return status.Errorf(codes.InvalidArgument, "invalid peer record: %v", err)
+		}
+		if !d.newestRecord(record) {
+			d.logger.Debug("ignoring outdated peer record", "peer", record.EthAddress, "from_peer", from)
+			continue
+		}
+		if d.topo.IsConnected(record.EthAddress) {
 			continue
 		}
+		if d.filter != nil {
+			underlay, err := d.filter.FilterUnderlay(p.Underlay)
+			if err != nil {
+				d.logger.Debug("ignoring announced peer", "err", err, "from_peer", from)
+				continue
+			}
+			p.Underlay = underlay
+		}
 		select {
 		case d.checkPeers <- p:
 		case <-ctx.Done():
-			d.logger.Error("failed to add peer", "err", ctx.Err(), "from_peer", peer)
+			d.logger.Error("failed to add peer", "err", ctx.Err(), "from_peer", from)
 			return ctx.Err()
 		}
 	}
 
-	d.logger.Debug("added peers", "peers", len(peers.Peers), "from_peer", peer)
+	d.logger.Debug("added peers", "peers", len(peers), "from_peer", from)
 	return nil
 }
 
+// allowAnnouncement returns false if the peer announced too many peers
+// recently.
+func (d *Discovery) allowAnnouncement(from p2p.Peer, n int) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	l, found := d.announceLimiters[from.EthAddress]
+	if !found {
+		l = rate.NewLimiter(announceRate, maxPeerListSize)
+		d.announceLimiters[from.EthAddress] = l
+	}
+	return l.AllowN(d.now(), n)
+}
+
+// newestRecord returns false if a newer record of the peer was seen before,
+// so that outdated underlays are not dialed.
+func (d *Discovery) newestRecord(record p2p.PeerInfo) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if latest, found := d.records[record.EthAddress]; found && record.Timestamp < latest {
+		return false
+	}
+	d.records[record.EthAddress] = record.Timestamp
+	return true
+}
+
+func toPeerList(peers []p2p.PeerInfo) *discoverypb.PeerList {
+	list := &discoverypb.PeerList{
+		Peers: make([]*discoverypb.PeerInfo, 0, len(peers)),
+	}
+	for _, p := range peers {
+		list.Peers = append(list.Peers, &discoverypb.PeerInfo{
+			EthAddress: p.EthAddress.Bytes(),
+			Underlay:   p.Underlay,
+			Timestamp:  p.Timestamp,
+			Signature:  p.Signature,
+		})
+	}
+	return list
+}
+
+// BroadcastPeers queues the peers to be sent to the peer and returns
+// immediately. Broadcasts to the same peer are merged until they are sent and
+// peers which were sent to it recently are skipped.
 func (d *Discovery) BroadcastPeers(
-	ctx context.Context,
+	_ context.Context,
 	peer p2p.Peer,
 	peers []p2p.PeerInfo,
 ) error {
+	now := d.now()
+
+	d.mu.Lock()
+	b, found := d.pending[peer.EthAddress]
+	if !found {
+		b = &broadcast{peer: peer, peers: make(map[common.Address]p2p.PeerInfo)}
+	}
+	for _, p := range peers {
+		if d.recentlySent(peer.EthAddress, p, now) {
+			d.metrics.DuplicatePeersCount.Inc()
+			continue
+		}
+		b.peers[p.EthAddress] = p
+	}
+	if !found && len(b.peers) > 0 {
+		d.pending[peer.EthAddress] = b
+	}
+	d.metrics.PendingBroadcasts.Set(float64(len(d.pending)))
+	d.mu.Unlock()
+
+	select {
+	case d.wakeBroadcast <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Disconnected forgets the peers which were sent to the disconnected peer, it
+// receives all of them again when it reconnects.
+func (d *Discovery) Disconnected(peer p2p.Peer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.sent, peer.EthAddress)
+	delete(d.pending, peer.EthAddress)
+	d.metrics.PendingBroadcasts.Set(float64(len(d.pending)))
+}
+
+// recentlySent must be called with the lock held.
+func (d *Discovery) recentlySent(to common.Address, p p2p.PeerInfo, now time.Time) bool {
+	sp, found := d.sent[to][p.EthAddress]
+	return found && sp.underlay == string(p.Underlay) && now.Sub(sp.at) < dedupWindow
+}
+
+func (d *Discovery) broadcastLoop() {
+	for {
+		select {
+		case <-d.quit:
+			return
+		case <-d.wakeBroadcast:
+		}
+
+		d.mu.Lock()
+		pending := d.pending
+		d.pending = make(map[common.Address]*broadcast)
+		d.metrics.PendingBroadcasts.Set(0)
+		d.mu.Unlock()
+
+		for _, b := range pending {
+			_ = d.broadcastSem.Acquire(context.Background(), 1)
+			go func(b *broadcast) {
+				defer d.broadcastSem.Release(1)
+
+				peers := make([]p2p.PeerInfo, 0, len(b.peers))
+				for _, p := range b.peers {
+					peers = append(peers, p)
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+				defer cancel()
+
+				if err := d.sendPeers(ctx, b.peer, peers); err != nil {
+					d.metrics.FailedBroadcastsCount.Inc()
+					return
+				}
+
+				now := d.now()
+				d.mu.Lock()
+				sent, found := d.sent[b.peer.EthAddress]
+				if !found {
+					sent = make(map[common.Address]sentPeer)
+					d.sent[b.peer.EthAddress] = sent
+				}
+				for _, p := range peers {
+					sent[p.EthAddress] = sentPeer{underlay: string(p.Underlay), at: now}
+				}
+				d.mu.Unlock()
+			}(b)
+		}
+	}
+}
+
+func (d *Discovery) sendPeers(ctx context.Context, peer p2p.Peer, peers []p2p.PeerInfo) error {
 	stream, err := d.streamer.NewStream(ctx, peer, nil, d.peerListStream())
 	if err != nil {
 		d.logger.Error("failed to create stream", "err", err, "to_peer", peer)
@@ -102,23 +451,131 @@ func (d *Discovery) BroadcastPeers(
 	}
 	defer stream.Close()
 
-	peersToSend := make([]*discoverypb.PeerInfo, 0, len(peers))
-	for _, p := range peers {
-		peersToSend = append(peersToSend, &discoverypb.PeerInfo{
-			EthAddress: p.EthAddress.Bytes(),
-			Underlay:   p.Underlay,
-		})
-	}
-
-	if err := stream.WriteMsg(ctx, &discoverypb.PeerList{Peers: peersToSend}); err != nil {
+	if err := stream.WriteMsg(ctx, toPeerList(peers)); err != nil {
 		d.logger.Error("failed to write peers list", "err", err, "to_peer", peer)
 		return err
 	}
 
+	d.metrics.SentPeersCount.Add(float64(len(peers)))
 	d.logger.Debug("sent peers list", "peers", len(peers), "to_peer", peer)
 	return nil
 }
 
+// SyncPeers requests the connected providers from the peer and dials the
+// ones which are not connected.
+func (d *Discovery) SyncPeers(ctx context.Context, peer p2p.Peer) error {
+	stream, err := d.streamer.NewStream(ctx, peer, nil, d.getPeersStream())
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := stream.WriteMsg(ctx, &discoverypb.GetPeersRequest{}); err != nil {
+		return err
+	}
+
+	peers := new(discoverypb.PeerList)
+	if err := stream.ReadMsg(ctx, peers); err != nil {
+		return err
+	}
+
+	return d.addPeers(ctx, peer, peers.Peers)
+}
+
+func (d *Discovery) resyncLoop() {
+	ticker := time.NewTicker(resyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.quit:
+			return
+		case <-ticker.C:
+			d.prune(d.now())
+			d.Resync()
+		}
+	}
+}
+
+// Resync requests the peers from the connected bootnodes and providers, which
+// know all the providers they are connected to.
+func (d *Discovery) Resync() {
+	for _, p := range d.streamer.Peers() {
+		if p.Type == p2p.PeerTypeBidder {
+			continue
+		}
+		caps := p.Capabilities
+		if caps != nil && !caps.SupportsProtocol(GetPeersProtocolName, GetPeersProtocolVersion) {
+			continue
+		}
+
+		_ = d.broadcastSem.Acquire(context.Background(), 1)
+		go func(p p2p.Peer) {
+			defer d.broadcastSem.Release(1)
+
+			ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+			defer cancel()
+
+			if err := d.SyncPeers(ctx, p); err != nil {
+				d.metrics.FailedSyncsCount.Inc()
+				d.logger.Warn("failed to sync peers", "err", err, "peer", p)
+			}
+		}(p)
+	}
+}
+
+// prune removes the state of peers which is not needed anymore.
+func (d *Discovery) prune(now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for to, sent := range d.sent {
+		for addr, sp := range sent {
+			if now.Sub(sp.at) >= dedupWindow {
+				delete(sent, addr)
+			}
+		}
+		if len(sent) == 0 {
+			delete(d.sent, to)
+		}
+	}
+	for addr, ts := range d.records {
+		if now.Sub(time.Unix(ts, 0)) >= recordTTL {
+			delete(d.records, addr)
+		}
+	}
+	// Full limiters are the same as new ones.
+	for addr, l := range d.announceLimiters {
+		if l.TokensAt(now) >= maxPeerListSize {
+			delete(d.announceLimiters, addr)
+		}
+	}
+}
+
+// Reconnect dials the peers which were connected before the node was
+// restarted. It doesn't block, the peers are checked like announced peers.
+func (d *Discovery) Reconnect(peers []p2p.PeerInfo) {
+	if len(peers) == 0 {
+		return
+	}
+	d.logger.Info("reconnecting to known peers", "peers", len(peers))
+	go func() {
+		for _, p := range peers {
+			if d.topo.IsConnected(p.EthAddress) {
+				continue
+			}
+			select {
+			case d.checkPeers <- &discoverypb.PeerInfo{
+				EthAddress: p.EthAddress.Bytes(),
+				Underlay:   p.Underlay,
+			}:
+			case <-d.quit:
+				return
+			}
+		}
+	}()
+}
+
 func (d *Discovery) Close() error {
 	close(d.quit)
 	return nil
@@ -130,12 +587,29 @@ func (d *Discovery) checkAndAddPeers() {
 		case <-d.quit:
 			return
 		case peer := <-d.checkPeers:
+			addr := common.BytesToAddress(peer.EthAddress)
+			// The same peer may be announced by several peers at once.
+			d.mu.Lock()
+			_, dialing := d.connecting[addr]
+			d.connecting[addr] = struct{}{}
+			d.mu.Unlock()
+			if dialing {
+				continue
+			}
+
 			_ = d.sem.Acquire(context.Background(), 1)
 			go func() {
 				defer d.sem.Release(1)
+				defer func() {
+					d.mu.Lock()
+					delete(d.connecting, addr)
+					d.mu.Unlock()
+				}()
 
+				d.metrics.ConnectAttemptsCount.Inc()
 				p, err := d.streamer.Connect(context.Background(), peer.Underlay)
 				if err != nil {
+					d.metrics.FailedConnectionsCount.Inc()
 					d.logger.Error("failed to connect to peer", "err", err, "peer", peer)
 					return
 				}
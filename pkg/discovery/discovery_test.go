@@ -1,7 +1,9 @@
 package discovery_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"io"
 	"log/slog"
@@ -11,14 +13,22 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	discoverypb "github.com/primevprotocol/mev-commit/gen/go/discovery/v1"
 	"github.com/primevprotocol/mev-commit/pkg/discovery"
+	mockkeysigner "github.com/primevprotocol/mev-commit/pkg/keysigner/mock"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/peerrecord"
 	p2ptest "github.com/primevprotocol/mev-commit/pkg/p2p/testing"
 )
 
 type testTopo struct {
-	mu    sync.Mutex
-	peers []p2p.Peer
+	mu        sync.Mutex
+	peers     []p2p.Peer
+	providers []p2p.PeerInfo
 }
 
 func (t *testTopo) AddPeers(peers ...p2p.Peer) {
@@ -40,6 +50,16 @@ func (t *testTopo) IsConnected(addr common.Address) bool {
 	return false
 }
 
+func (t *testTopo) PeerInfos(_ p2p.PeerType, exclude common.Address) []p2p.PeerInfo {
+	var res []p2p.PeerInfo
+	for _, p := range t.providers {
+		if p.EthAddress != exclude {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
 func (t *testTopo) Peers() int {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -54,12 +74,46 @@ func newTestLogger(w io.Writer) *slog.Logger {
 	return slog.New(testLogger)
 }
 
+// newTestRecord returns a peer record signed by a new key.
+func newTestRecord(t *testing.T) p2p.PeerInfo {
+	t.Helper()
+
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := mockkeysigner.NewMockKeySigner(privKey, crypto.PubkeyToAddress(privKey.PublicKey))
+
+	_, pubKey, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerID, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	underlay, err := peer.AddrInfo{
+		ID:    peerID,
+		Addrs: []multiaddr.Multiaddr{multiaddr.StringCast("/ip4/1.2.3.4/tcp/13522")},
+	}.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := peerrecord.Sign(ks, underlay, time.Now().Unix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
 func TestDiscovery(t *testing.T) {
 	t.Parallel()
 
 	t.Run("ok", func(t *testing.T) {
+		record := newTestRecord(t)
 		client := p2p.Peer{
-			EthAddress: common.HexToAddress("0x1"),
+			EthAddress: record.EthAddress,
 			Type:       p2p.PeerTypeProvider,
 		}
 		server := p2p.Peer{
@@ -70,7 +124,7 @@ func TestDiscovery(t *testing.T) {
 		svc := p2ptest.New(
 			&client,
 			p2ptest.WithConnectFunc(func(addr []byte) (p2p.Peer, error) {
-				if string(addr) != "test" {
+				if !bytes.Equal(addr, record.Underlay) {
 					return p2p.Peer{}, errors.New("invalid address")
 				}
 				return client, nil
@@ -88,13 +142,109 @@ func TestDiscovery(t *testing.T) {
 
 		svc.SetPeerHandler(server, d.Streams()[0])
 
-		err := d.BroadcastPeers(context.Background(), server, []p2p.PeerInfo{
+		err := d.BroadcastPeers(context.Background(), server, []p2p.PeerInfo{record})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Now()
+		for {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out")
+			}
+			if topo.Peers() == 1 {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	})
+
+	t.Run("reconnect", func(t *testing.T) {
+		self := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeProvider,
+		}
+		known := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeBidder,
+		}
+
+		svc := p2ptest.New(
+			&self,
+			p2ptest.WithConnectFunc(func(addr []byte) (p2p.Peer, error) {
+				if string(addr) != "known" {
+					return p2p.Peer{}, errors.New("invalid address")
+				}
+				return known, nil
+			}),
+		)
+
+		topo := &testTopo{}
+		d := discovery.New(topo, svc, newTestLogger(os.Stdout))
+		t.Cleanup(func() {
+			err := d.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		d.Reconnect([]p2p.PeerInfo{
 			{
-				EthAddress: common.HexToAddress("0x1"),
-				Underlay:   []byte("test"),
+				EthAddress: known.EthAddress,
+				Underlay:   []byte("known"),
 			},
 		})
-		if err != nil {
+
+		start := time.Now()
+		for {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out")
+			}
+			if topo.Peers() == 1 {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	})
+	t.Run("get peers", func(t *testing.T) {
+		record := newTestRecord(t)
+		client := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeBidder,
+		}
+		server := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeBootnode,
+		}
+		provider := p2p.Peer{
+			EthAddress: record.EthAddress,
+			Type:       p2p.PeerTypeProvider,
+		}
+
+		svc := p2ptest.New(
+			&client,
+			p2ptest.WithConnectFunc(func(addr []byte) (p2p.Peer, error) {
+				if !bytes.Equal(addr, record.Underlay) {
+					return p2p.Peer{}, errors.New("invalid address")
+				}
+				return provider, nil
+			}),
+		)
+
+		// The discovery answers its own request with the providers of the
+		// test topology.
+		topo := &testTopo{providers: []p2p.PeerInfo{record}}
+		d := discovery.New(topo, svc, newTestLogger(os.Stdout))
+		t.Cleanup(func() {
+			err := d.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svc.SetPeerHandler(server, d.Streams()[1])
+
+		if err := d.SyncPeers(context.Background(), server); err != nil {
 			t.Fatal(err)
 		}
 
@@ -103,10 +253,105 @@ func TestDiscovery(t *testing.T) {
 			if time.Since(start) > 5*time.Second {
 				t.Fatal("timed out")
 			}
-			if topo.Peers() == 1 {
+			if topo.IsConnected(provider.EthAddress) {
 				break
 			}
 			time.Sleep(100 * time.Millisecond)
 		}
 	})
+
+	t.Run("deduplicated broadcast", func(t *testing.T) {
+		self := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeProvider,
+		}
+		server := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeBidder,
+		}
+
+		var (
+			mu       sync.Mutex
+			received []*discoverypb.PeerInfo
+		)
+		svc := p2ptest.New(&self)
+		svc.SetPeerHandler(server, p2p.StreamDesc{
+			Name:    discovery.ProtocolName,
+			Version: discovery.ProtocolVersion,
+			Handler: func(ctx context.Context, _ p2p.Peer, s p2p.Stream) error {
+				peers := new(discoverypb.PeerList)
+				if err := s.ReadMsg(ctx, peers); err != nil {
+					return err
+				}
+				mu.Lock()
+				received = append(received, peers.Peers...)
+				mu.Unlock()
+				return nil
+			},
+		})
+		numReceived := func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received)
+		}
+
+		d := discovery.New(&testTopo{}, svc, newTestLogger(os.Stdout))
+		t.Cleanup(func() {
+			err := d.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		peers := []p2p.PeerInfo{
+			{EthAddress: common.HexToAddress("0x3"), Underlay: []byte("peer3")},
+			{EthAddress: common.HexToAddress("0x4"), Underlay: []byte("peer4")},
+		}
+		if err := d.BroadcastPeers(context.Background(), server, peers); err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Now()
+		for numReceived() != 2 {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		// Only the peer with the new underlay is sent again.
+		peers[1].Underlay = []byte("peer4-new")
+		if err := d.BroadcastPeers(context.Background(), server, peers); err != nil {
+			t.Fatal(err)
+		}
+
+		start = time.Now()
+		for numReceived() != 3 {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		time.Sleep(100 * time.Millisecond)
+
+		mu.Lock()
+		if len(received) != 3 || string(received[2].Underlay) != "peer4-new" {
+			t.Fatalf("unexpected peers %v", received)
+		}
+		mu.Unlock()
+
+		// A peer which reconnects receives all peers again.
+		d.Disconnected(server)
+		if err := d.BroadcastPeers(context.Background(), server, peers); err != nil {
+			t.Fatal(err)
+		}
+
+		start = time.Now()
+		for numReceived() != 5 {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
 }
@@ -0,0 +1,109 @@
+package discovery
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	defaultNamespace = "mev_commit"
+	subsystem        = "discovery"
+)
+
+type metrics struct {
+	ReceivedPeersCount     prometheus.Counter
+	SentPeersCount         prometheus.Counter
+	DuplicatePeersCount    prometheus.Counter
+	PendingBroadcasts      prometheus.Gauge
+	FailedBroadcastsCount  prometheus.Counter
+	GetPeersRequestsCount  prometheus.Counter
+	FailedSyncsCount       prometheus.Counter
+	ConnectAttemptsCount   prometheus.Counter
+	FailedConnectionsCount prometheus.Counter
+	InvalidRecordsCount    prometheus.Counter
+	RateLimitedListsCount  prometheus.Counter
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		ReceivedPeersCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "received_peers_count",
+			Help:      "Number of peers received in peer lists",
+		}),
+		SentPeersCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "sent_peers_count",
+			Help:      "Number of peers sent in peer lists",
+		}),
+		DuplicatePeersCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "duplicate_peers_count",
+			Help:      "Number of peers not broadcasted as they were sent recently",
+		}),
+		PendingBroadcasts: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "pending_broadcasts",
+			Help:      "Number of peers with a pending broadcast",
+		}),
+		FailedBroadcastsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "failed_broadcasts_count",
+			Help:      "Number of peer lists which could not be sent",
+		}),
+		GetPeersRequestsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "get_peers_requests_count",
+			Help:      "Number of answered get peers requests",
+		}),
+		FailedSyncsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "failed_syncs_count",
+			Help:      "Number of failed get peers requests to other peers",
+		}),
+		ConnectAttemptsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "connect_attempts_count",
+			Help:      "Number of connection attempts to discovered peers",
+		}),
+		FailedConnectionsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "failed_connections_count",
+			Help:      "Number of failed connection attempts to discovered peers",
+		}),
+		InvalidRecordsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "invalid_records_count",
+			Help:      "Number of received peer records with an invalid signature",
+		}),
+		RateLimitedListsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "rate_limited_lists_count",
+			Help:      "Number of peer lists rejected because of the announcement rate",
+		}),
+	}
+}
+
+func (d *Discovery) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		d.metrics.ReceivedPeersCount,
+		d.metrics.SentPeersCount,
+		d.metrics.DuplicatePeersCount,
+		d.metrics.PendingBroadcasts,
+		d.metrics.FailedBroadcastsCount,
+		d.metrics.GetPeersRequestsCount,
+		d.metrics.FailedSyncsCount,
+		d.metrics.ConnectAttemptsCount,
+		d.metrics.FailedConnectionsCount,
+		d.metrics.InvalidRecordsCount,
+		d.metrics.RateLimitedListsCount,
+	}
+}
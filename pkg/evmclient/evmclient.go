@@ -43,6 +43,7 @@ type Interface interface {
 	WaitForReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	Call(ctx context.Context, tx *TxRequest) ([]byte, error)
 	CancelTx(ctx context.Context, txHash common.Hash) (common.Hash, error)
+	PendingTxns() []TxnInfo
 }
 
 type EvmClient struct {
@@ -59,8 +60,10 @@ type EvmClient struct {
 }
 
 type txnDetails struct {
-	nonce   uint64
-	created time.Time
+	nonce    uint64
+	created  time.Time
+	to       *common.Address
+	callData []byte
 }
 
 func New(
@@ -215,7 +218,12 @@ func (c *EvmClient) Send(ctx context.Context, tx *TxRequest) (common.Hash, error
 	c.nonce++
 	c.logger.Info("sent txn", "tx", txnString(txnData), "txHash", signedTx.Hash().Hex())
 
-	c.sentTxs[signedTx.Hash()] = txnDetails{nonce: nonce, created: time.Now()}
+	c.sentTxs[signedTx.Hash()] = txnDetails{
+		nonce:    nonce,
+		created:  time.Now(),
+		to:       tx.To,
+		callData: tx.CallData,
+	}
 	c.waitForTxn(signedTx.Hash(), nonce)
 
 	return signedTx.Hash(), nil
@@ -377,7 +385,11 @@ func (c *EvmClient) CancelTx(ctx context.Context, txnHash common.Hash) (common.H
 	c.metrics.CancelledTxCount.Inc()
 	c.logger.Info("sent cancel txn", "txHash", signedTx.Hash().Hex())
 
-	c.sentTxs[signedTx.Hash()] = txnDetails{nonce: txn.Nonce(), created: time.Now()}
+	c.sentTxs[signedTx.Hash()] = txnDetails{
+		nonce:   txn.Nonce(),
+		created: time.Now(),
+		to:      tx.To(),
+	}
 	c.waitForTxn(signedTx.Hash(), txn.Nonce())
 
 	return signedTx.Hash(), nil
@@ -387,6 +399,9 @@ type TxnInfo struct {
 	Hash    string
 	Nonce   uint64
 	Created string
+	// To and CallData identify the contract call of the transaction.
+	To       *common.Address
+	CallData []byte
 }
 
 func (c *EvmClient) PendingTxns() []TxnInfo {
@@ -396,9 +411,11 @@ func (c *EvmClient) PendingTxns() []TxnInfo {
 	var txns []TxnInfo
 	for hash, d := range c.sentTxs {
 		txns = append(txns, TxnInfo{
-			Hash:    hash.Hex(),
-			Nonce:   d.nonce,
-			Created: d.created.String(),
+			Hash:     hash.Hex(),
+			Nonce:    d.nonce,
+			Created:  d.created.String(),
+			To:       d.to,
+			CallData: d.callData,
 		})
 	}
 
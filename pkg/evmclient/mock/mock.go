@@ -51,11 +51,18 @@ func WithCancelFunc(
 	}
 }
 
+func WithPendingTxnsFunc(f func() []evmclient.TxnInfo) Option {
+	return func(m *mockEvmClient) {
+		m.PendingTxnsFunc = f
+	}
+}
+
 type mockEvmClient struct {
 	SendFunc           func(ctx context.Context, req *evmclient.TxRequest) (common.Hash, error)
 	WaitForReceiptFunc func(ctx context.Context, txnHash common.Hash) (*types.Receipt, error)
 	CallFunc           func(ctx context.Context, req *evmclient.TxRequest) ([]byte, error)
 	CancelFunc         func(ctx context.Context, txHash common.Hash) (common.Hash, error)
+	PendingTxnsFunc    func() []evmclient.TxnInfo
 }
 
 func (m *mockEvmClient) Send(
@@ -91,3 +98,10 @@ func (m *mockEvmClient) CancelTx(ctx context.Context, txHash common.Hash) (commo
 	}
 	return m.CancelFunc(ctx, txHash)
 }
+
+func (m *mockEvmClient) PendingTxns() []evmclient.TxnInfo {
+	if m.PendingTxnsFunc == nil {
+		return nil
+	}
+	return m.PendingTxnsFunc()
+}
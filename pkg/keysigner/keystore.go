@@ -0,0 +1,176 @@
+package keysigner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primevprotocol/mev-commit/pkg/util"
+)
+
+// selectedAccountFile stores the address of the account used by the node if
+// the keystore holds more than one account. The keystore ignores hidden files
+// so it can live in the keystore directory.
+const selectedAccountFile = ".selected"
+
+var (
+	ErrNoAccounts      = errors.New("no accounts in keystore")
+	ErrAccountNotFound = errors.New("account not found in keystore")
+	ErrKeyFileNotFound = errors.New("private key file not found")
+)
+
+// Keystore manages the encrypted accounts in a keystore directory.
+type Keystore struct {
+	path string
+	ks   *keystore.KeyStore
+}
+
+func OpenKeystore(path string) (*Keystore, error) {
+	dir, err := util.ResolveFilePath(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keystore path: %w", err)
+	}
+
+	return &Keystore{
+		path: dir,
+		// lightscripts are using 4MB memory and taking approximately 100ms CPU time on a modern processor to decrypt
+		ks: keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP),
+	}, nil
+}
+
+func (k *Keystore) Path() string {
+	return k.path
+}
+
+func (k *Keystore) Accounts() []accounts.Account {
+	return k.ks.Accounts()
+}
+
+// Create generates a new account encrypted with password.
+func (k *Keystore) Create(password string) (accounts.Account, error) {
+	return k.ks.NewAccount(password)
+}
+
+// ImportKeyFile imports a hex encoded private key file as the ones read by
+// the PrivateKeySigner.
+func (k *Keystore) ImportKeyFile(keyFile, password string) (accounts.Account, error) {
+	path, err := util.ResolveFilePath(keyFile)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	key, err := crypto.LoadECDSA(path)
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("failed to load private key from file '%s': %w", path, err)
+	}
+	defer zeroKey(key)
+
+	return k.ks.ImportECDSA(key, password)
+}
+
+// Export returns the encrypted JSON of the account re-encrypted with
+// newPassword.
+func (k *Keystore) Export(address common.Address, password, newPassword string) ([]byte, error) {
+	account, err := k.find(address)
+	if err != nil {
+		return nil, err
+	}
+	return k.ks.Export(account, password, newPassword)
+}
+
+// Select makes address the account used by the node.
+func (k *Keystore) Select(address common.Address) error {
+	if _, err := k.find(address); err != nil {
+		return err
+	}
+
+	return os.WriteFile(
+		filepath.Join(k.path, selectedAccountFile),
+		[]byte(address.Hex()+"\n"),
+		0600,
+	)
+}
+
+// Selected returns the account used by the node. It is the account chosen
+// with Select or the only account in the keystore.
+func (k *Keystore) Selected() (accounts.Account, error) {
+	data, err := os.ReadFile(filepath.Join(k.path, selectedAccountFile))
+	switch {
+	case err == nil:
+		address := strings.TrimSpace(string(data))
+		if !common.IsHexAddress(address) {
+			return accounts.Account{}, fmt.Errorf("invalid selected address %q", address)
+		}
+		return k.find(common.HexToAddress(address))
+	case !errors.Is(err, fs.ErrNotExist):
+		return accounts.Account{}, err
+	}
+
+	all := k.ks.Accounts()
+	switch len(all) {
+	case 0:
+		return accounts.Account{}, ErrNoAccounts
+	case 1:
+		return all[0], nil
+	default:
+		return accounts.Account{}, fmt.Errorf(
+			"keystore has %d accounts, select one by address",
+			len(all),
+		)
+	}
+}
+
+// MigrateKeyFile imports the plaintext private key file into the keystore and
+// removes the key file once the imported account has been verified.
+func (k *Keystore) MigrateKeyFile(keyFile, password string) (accounts.Account, error) {
+	path, err := util.ResolveFilePath(keyFile)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	account, err := k.ImportKeyFile(path, password)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	// Make sure the account can be decrypted before the only other copy of
+	// the key is removed.
+	if err := k.ks.Unlock(account, password); err != nil {
+		return accounts.Account{}, fmt.Errorf("failed to verify imported account: %w", err)
+	}
+	_ = k.ks.Lock(account.Address)
+
+	if err := wipeFile(path); err != nil {
+		return accounts.Account{}, fmt.Errorf("failed to remove key file '%s': %w", path, err)
+	}
+
+	return account, nil
+}
+
+func (k *Keystore) find(address common.Address) (accounts.Account, error) {
+	account, err := k.ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("%w: %s", ErrAccountNotFound, address.Hex())
+	}
+	return account, nil
+}
+
+// wipeFile overwrites the file with zeros before removing it.
+func wipeFile(path string) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, bytes.Repeat([]byte{0}, int(fi.Size())), 0600); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
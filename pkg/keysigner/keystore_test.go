@@ -0,0 +1,143 @@
+package keysigner_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primevprotocol/mev-commit/pkg/keysigner"
+)
+
+func TestKeystore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create and select", func(t *testing.T) {
+		dir := t.TempDir()
+
+		if _, err := keysigner.NewKeystoreSigner(dir, "test", common.Address{}, false); !errors.Is(err, keysigner.ErrNoAccounts) {
+			t.Fatalf("expected error %v, got %v", keysigner.ErrNoAccounts, err)
+		}
+
+		ks, err := keysigner.OpenKeystore(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		acc1, err := ks.Create("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		acc2, err := ks.Create("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := keysigner.NewKeystoreSigner(dir, "test", common.Address{}, false); err == nil {
+			t.Fatal("expected error without selected account")
+		}
+
+		if err := ks.Select(acc2.Address); err != nil {
+			t.Fatal(err)
+		}
+		signer, err := keysigner.NewKeystoreSigner(dir, "test", common.Address{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer.GetAddress() != acc2.Address {
+			t.Fatalf("expected address %s, got %s", acc2.Address.Hex(), signer.GetAddress().Hex())
+		}
+
+		signer, err = keysigner.NewKeystoreSigner(dir, "test", acc1.Address, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer.GetAddress() != acc1.Address {
+			t.Fatalf("expected address %s, got %s", acc1.Address.Hex(), signer.GetAddress().Hex())
+		}
+
+		err = ks.Select(common.HexToAddress("0x1"))
+		if !errors.Is(err, keysigner.ErrAccountNotFound) {
+			t.Fatalf("expected error %v, got %v", keysigner.ErrAccountNotFound, err)
+		}
+	})
+
+	t.Run("export", func(t *testing.T) {
+		ks, err := keysigner.OpenKeystore(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		acc, err := ks.Create("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		keyJSON, err := ks.Export(acc.Address, "test", "other")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		other, err := keysigner.OpenKeystore(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyFile := filepath.Join(other.Path(), "exported")
+		if err := os.WriteFile(keyFile, keyJSON, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := keysigner.NewKeystoreSigner(other.Path(), "other", acc.Address, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("migrate key file", func(t *testing.T) {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyFile := filepath.Join(t.TempDir(), "key")
+		if err := crypto.SaveECDSA(keyFile, key); err != nil {
+			t.Fatal(err)
+		}
+
+		ks, err := keysigner.OpenKeystore(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		acc, err := ks.MigrateKeyFile(keyFile, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acc.Address != crypto.PubkeyToAddress(key.PublicKey) {
+			t.Fatalf("expected address %s, got %s", crypto.PubkeyToAddress(key.PublicKey).Hex(), acc.Address.Hex())
+		}
+		if _, err := os.Stat(keyFile); !os.IsNotExist(err) {
+			t.Fatalf("expected key file to be removed, got %v", err)
+		}
+
+		signer, err := keysigner.NewKeystoreSigner(ks.Path(), "test", common.Address{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer.GetAddress() != acc.Address {
+			t.Fatalf("expected address %s, got %s", acc.Address.Hex(), signer.GetAddress().Hex())
+		}
+	})
+
+	t.Run("private key file is not created implicitly", func(t *testing.T) {
+		keyFile := filepath.Join(t.TempDir(), "key")
+
+		_, err := keysigner.NewPrivateKeySigner(keyFile, false)
+		if !errors.Is(err, keysigner.ErrKeyFileNotFound) {
+			t.Fatalf("expected error %v, got %v", keysigner.ErrKeyFileNotFound, err)
+		}
+
+		if _, err := keysigner.NewPrivateKeySigner(keyFile, true); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(keyFile); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
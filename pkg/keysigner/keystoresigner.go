@@ -4,7 +4,6 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
-	"runtime"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
@@ -18,24 +17,37 @@ type KeystoreSigner struct {
 	account  accounts.Account
 }
 
-func NewKeystoreSigner(path, password string) (*KeystoreSigner, error) {
-	// lightscripts are using 4MB memory and taking approximately 100ms CPU time on a modern processor to decrypt
-	keystore := keystore.NewKeyStore(path, keystore.LightScryptN, keystore.LightScryptP)
-	ksAccounts := keystore.Accounts()
+// NewKeystoreSigner creates a signer for an account in the keystore at path.
+// If address is not set, the selected account is used. A new account is only
+// created if the keystore is empty and create is set.
+func NewKeystoreSigner(
+	path, password string,
+	address common.Address,
+	create bool,
+) (*KeystoreSigner, error) {
+	ks, err := OpenKeystore(path)
+	if err != nil {
+		return nil, err
+	}
 
 	var account accounts.Account
-	if len(ksAccounts) == 0 {
-		var err error
-		account, err = keystore.NewAccount(password)
+	switch {
+	case address != (common.Address{}):
+		account, err = ks.find(address)
+	case create && len(ks.Accounts()) == 0:
+		account, err = ks.Create(password)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create account: %w", err)
+			err = fmt.Errorf("failed to create account: %w", err)
 		}
-	} else {
-		account = ksAccounts[0]
+	default:
+		account, err = ks.Selected()
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return &KeystoreSigner{
-		keystore: keystore,
+		keystore: ks.ks,
 		password: password,
 		account:  account,
 	}, nil
@@ -58,12 +70,7 @@ func (kss *KeystoreSigner) GetPrivateKey() (*ecdsa.PrivateKey, error) {
 }
 
 func (kss *KeystoreSigner) ZeroPrivateKey(key *ecdsa.PrivateKey) {
-	b := key.D.Bits()
-	for i := range b {
-		b[i] = 0
-	}
-	// Force garbage collection to remove the key from memory
-	runtime.GC()
+	zeroKey(key)
 }
 
 func (kss *KeystoreSigner) String() string {
@@ -2,16 +2,19 @@ package keysigner
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"io/fs"
 	"math/big"
 	"os"
 	"path/filepath"
-	"strings"
+	"runtime"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primevprotocol/mev-commit/pkg/util"
 )
 
 type PrivateKeySigner struct {
@@ -19,14 +22,21 @@ type PrivateKeySigner struct {
 	privKey *ecdsa.PrivateKey
 }
 
-func NewPrivateKeySigner(path string) (*PrivateKeySigner, error) {
-	privKeyFile, err := resolveFilePath(path)
+// NewPrivateKeySigner loads the plaintext private key file at path. The key
+// file is only created if it does not exist and create is set.
+func NewPrivateKeySigner(path string, create bool) (*PrivateKeySigner, error) {
+	privKeyFile, err := util.ResolveFilePath(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get private key file path: %w", err)
 	}
 
-	if err := createKeyIfNotExists(privKeyFile); err != nil {
-		return nil, fmt.Errorf("failed to create private key: %w", err)
+	if _, err := os.Stat(privKeyFile); errors.Is(err, fs.ErrNotExist) {
+		if !create {
+			return nil, fmt.Errorf("%w: %s", ErrKeyFileNotFound, privKeyFile)
+		}
+		if err := createKey(privKeyFile); err != nil {
+			return nil, fmt.Errorf("failed to create private key: %w", err)
+		}
 	}
 
 	privKey, err := crypto.LoadECDSA(privKeyFile)
@@ -84,11 +94,16 @@ func extractPrivateKey(keystoreFile, passphrase string) (*ecdsa.PrivateKey, erro
 	return key.PrivateKey, nil
 }
 
-func createKeyIfNotExists(path string) error {
-	if _, err := os.Stat(path); err == nil {
-		return nil
+func zeroKey(key *ecdsa.PrivateKey) {
+	b := key.D.Bits()
+	for i := range b {
+		b[i] = 0
 	}
+	// Force garbage collection to remove the key from memory
+	runtime.GC()
+}
 
+func createKey(path string) error {
 	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
 		return err
 	}
@@ -100,20 +115,3 @@ func createKeyIfNotExists(path string) error {
 
 	return crypto.SaveECDSA(path, key)
 }
-
-func resolveFilePath(path string) (string, error) {
-	if path == "" {
-		return "", fmt.Errorf("path is empty")
-	}
-
-	if strings.HasPrefix(path, "~") {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return "", err
-		}
-
-		return filepath.Join(home, path[1:]), nil
-	}
-
-	return path, nil
-}
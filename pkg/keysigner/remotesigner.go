@@ -0,0 +1,281 @@
+package keysigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// Methods of the remote signer JSON-RPC API. Listing accounts and signing
+	// transactions follow the Clef external API. Clef has no method to sign a
+	// raw hash, so the signer (or a proxy in front of it) has to expose
+	// account_signHash which takes the signing account and a 32 byte hash.
+	remoteMethodList     = "account_list"
+	remoteMethodSignTx   = "account_signTransaction"
+	remoteMethodSignHash = "account_signHash"
+
+	defaultRemoteSignerTimeout = 10 * time.Second
+)
+
+var ErrPrivateKeyNotAvailable = errors.New("private key is not available for remote signer")
+
+// RemoteSignerOptions configures the connection to the remote signer.
+type RemoteSignerOptions struct {
+	// URL is the http(s) endpoint of the remote signer.
+	URL string
+	// Address is the account used for signing. If it is not set, the signer
+	// must manage exactly one account.
+	Address common.Address
+	// CACertFile is the PEM encoded CA used to verify the signer certificate.
+	// The system pool is used if it is empty.
+	CACertFile string
+	// ClientCertFile and ClientKeyFile are the PEM encoded certificate and
+	// key presented to the signer for mutual TLS.
+	ClientCertFile string
+	ClientKeyFile  string
+	// Timeout applies to each request sent to the signer.
+	Timeout time.Duration
+}
+
+// RemoteSigner is a KeySigner that delegates signing to an external signer
+// over JSON-RPC. The private key never leaves the signer.
+type RemoteSigner struct {
+	client  *rpc.Client
+	url     string
+	address common.Address
+	timeout time.Duration
+}
+
+func NewRemoteSigner(opts RemoteSignerOptions) (*RemoteSigner, error) {
+	tlsConfig, err := newRemoteSignerTLSConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tls config: %w", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultRemoteSignerTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	client, err := rpc.DialOptions(ctx, opts.URL, rpc.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+
+	var accounts []common.Address
+	if err := client.CallContext(ctx, &accounts, remoteMethodList); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to list remote signer accounts: %w", err)
+	}
+
+	address, err := selectRemoteAccount(accounts, opts.Address)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	return &RemoteSigner{
+		client:  client,
+		url:     opts.URL,
+		address: address,
+		timeout: timeout,
+	}, nil
+}
+
+func newRemoteSignerTLSConfig(opts RemoteSignerOptions) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if opts.CACertFile != "" {
+		caCert, err := os.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %s", opts.CACertFile)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (opts.ClientCertFile == "") != (opts.ClientKeyFile == "") {
+		return nil, errors.New("both client certificate and key are required")
+	}
+	if opts.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+func selectRemoteAccount(accounts []common.Address, want common.Address) (common.Address, error) {
+	if want == (common.Address{}) {
+		if len(accounts) != 1 {
+			return common.Address{}, fmt.Errorf("remote signer manages %d accounts, address must be specified", len(accounts))
+		}
+		return accounts[0], nil
+	}
+
+	for _, a := range accounts {
+		if a == want {
+			return a, nil
+		}
+	}
+	return common.Address{}, fmt.Errorf("account %s not managed by remote signer", want.Hex())
+}
+
+func (rs *RemoteSigner) SignHash(hash []byte) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, fmt.Errorf("invalid hash length %d", len(hash))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+
+	var sig hexutil.Bytes
+	err := rs.client.CallContext(
+		ctx,
+		&sig,
+		remoteMethodSignHash,
+		common.NewMixedcaseAddress(rs.address),
+		hexutil.Bytes(hash),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign hash: %w", err)
+	}
+
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+	// Signers following the Ethereum convention return V as 27/28, the
+	// rest of the node expects the 0/1 recovery ID returned by crypto.Sign.
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != rs.address {
+		return nil, errors.New("remote signer signed with unexpected account")
+	}
+
+	return sig, nil
+}
+
+func (rs *RemoteSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(rs.address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args.To = &to
+	}
+	if len(tx.Data()) > 0 {
+		data := hexutil.Bytes(tx.Data())
+		args.Input = &data
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	default:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+
+	var res struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := rs.client.CallContext(ctx, &res, remoteMethodSignTx, args); err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign transaction: %w", err)
+	}
+
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(res.Raw); err != nil {
+		return nil, fmt.Errorf("invalid transaction from remote signer: %w", err)
+	}
+
+	// Make sure the signer did not change the transaction.
+	if signedTx.Type() != tx.Type() ||
+		signedTx.Nonce() != tx.Nonce() ||
+		signedTx.Gas() != tx.Gas() ||
+		signedTx.GasPrice().Cmp(tx.GasPrice()) != 0 ||
+		signedTx.GasFeeCap().Cmp(tx.GasFeeCap()) != 0 ||
+		signedTx.GasTipCap().Cmp(tx.GasTipCap()) != 0 ||
+		signedTx.Value().Cmp(tx.Value()) != 0 ||
+		(signedTx.To() == nil) != (tx.To() == nil) ||
+		(tx.To() != nil && *signedTx.To() != *tx.To()) ||
+		!bytes.Equal(signedTx.Data(), tx.Data()) {
+		return nil, errors.New("remote signer returned a different transaction")
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction signature from remote signer: %w", err)
+	}
+	if sender != rs.address {
+		return nil, errors.New("remote signer signed with unexpected account")
+	}
+
+	return signedTx, nil
+}
+
+func (rs *RemoteSigner) GetAddress() common.Address {
+	return rs.address
+}
+
+// GetPrivateKey always fails as the key is held by the remote signer.
+func (rs *RemoteSigner) GetPrivateKey() (*ecdsa.PrivateKey, error) {
+	return nil, ErrPrivateKeyNotAvailable
+}
+
+// ZeroPrivateKey does nothing because the remote signer never exposes the key.
+func (rs *RemoteSigner) ZeroPrivateKey(key *ecdsa.PrivateKey) {}
+
+func (rs *RemoteSigner) String() string {
+	return rs.url
+}
+
+func (rs *RemoteSigner) Close() error {
+	rs.client.Close()
+	return nil
+}
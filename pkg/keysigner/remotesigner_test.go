@@ -0,0 +1,239 @@
+package keysigner_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/primevprotocol/mev-commit/pkg/keysigner"
+)
+
+// testSignerAPI is a stand-in for the remote signer.
+type testSignerAPI struct {
+	key   *ecdsa.PrivateKey
+	delay time.Duration
+	// feeBump is added to the fees of the transactions before they are
+	// signed.
+	feeBump int64
+}
+
+func (a *testSignerAPI) List(_ context.Context) ([]common.Address, error) {
+	return []common.Address{crypto.PubkeyToAddress(a.key.PublicKey)}, nil
+}
+
+func (a *testSignerAPI) SignHash(
+	_ context.Context,
+	addr common.MixedcaseAddress,
+	hash hexutil.Bytes,
+) (hexutil.Bytes, error) {
+	time.Sleep(a.delay)
+	if addr.Address() != crypto.PubkeyToAddress(a.key.PublicKey) {
+		return nil, errors.New("unknown account")
+	}
+	sig, err := crypto.Sign(hash, a.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+func (a *testSignerAPI) SignTransaction(
+	_ context.Context,
+	args apitypes.SendTxArgs,
+) (map[string]interface{}, error) {
+	if a.feeBump != 0 {
+		bump := big.NewInt(a.feeBump)
+		args.MaxFeePerGas = (*hexutil.Big)(new(big.Int).Add(args.MaxFeePerGas.ToInt(), bump))
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(new(big.Int).Add(args.MaxPriorityFeePerGas.ToInt(), bump))
+	}
+	tx := args.ToTransaction()
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), a.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signed}, nil
+}
+
+func newTestRemoteSigner(t *testing.T, api *testSignerAPI) *httptest.Server {
+	t.Helper()
+
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("account", api); err != nil {
+		t.Fatal(err)
+	}
+
+	ts := httptest.NewTLSServer(srv)
+	t.Cleanup(func() {
+		ts.Close()
+		srv.Stop()
+	})
+	return ts
+}
+
+func writeCACert(t *testing.T, ts *httptest.Server) string {
+	t.Helper()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return caFile
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	t.Run("sign hash and tx", func(t *testing.T) {
+		ts := newTestRemoteSigner(t, &testSignerAPI{key: key})
+
+		rs, err := keysigner.NewRemoteSigner(keysigner.RemoteSignerOptions{
+			URL:        ts.URL,
+			CACertFile: writeCACert(t, ts),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = rs.Close() })
+
+		if rs.GetAddress() != address {
+			t.Fatalf("expected address %s, got %s", address.Hex(), rs.GetAddress().Hex())
+		}
+
+		if _, err := rs.GetPrivateKey(); !errors.Is(err, keysigner.ErrPrivateKeyNotAvailable) {
+			t.Fatalf("expected error %v, got %v", keysigner.ErrPrivateKeyNotAvailable, err)
+		}
+
+		hash := crypto.Keccak256([]byte("test"))
+		sig, err := rs.SignHash(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKey, err := crypto.SigToPub(hash, sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crypto.PubkeyToAddress(*pubKey) != address {
+			t.Fatalf("expected signature from %s", address.Hex())
+		}
+
+		to := common.HexToAddress("0x1234")
+		chainID := big.NewInt(17864)
+		tx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     1,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(100),
+			Data:      []byte{0x01, 0x02},
+		})
+		signedTx, err := rs.SignTx(tx, chainID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sender != address {
+			t.Fatalf("expected sender %s, got %s", address.Hex(), sender.Hex())
+		}
+	})
+
+	t.Run("changed fees", func(t *testing.T) {
+		ts := newTestRemoteSigner(t, &testSignerAPI{key: key, feeBump: 1})
+
+		rs, err := keysigner.NewRemoteSigner(keysigner.RemoteSignerOptions{
+			URL:        ts.URL,
+			CACertFile: writeCACert(t, ts),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = rs.Close() })
+
+		to := common.HexToAddress("0x1234")
+		chainID := big.NewInt(17864)
+		tx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     1,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(100),
+		})
+		if _, err := rs.SignTx(tx, chainID); err == nil {
+			t.Fatal("expected error for transaction with changed fees")
+		}
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		ts := newTestRemoteSigner(t, &testSignerAPI{key: key})
+
+		_, err := keysigner.NewRemoteSigner(keysigner.RemoteSignerOptions{
+			URL:        ts.URL,
+			Address:    common.HexToAddress("0x1"),
+			CACertFile: writeCACert(t, ts),
+		})
+		if err == nil {
+			t.Fatal("expected error for unknown account")
+		}
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		ts := newTestRemoteSigner(t, &testSignerAPI{key: key})
+
+		_, err := keysigner.NewRemoteSigner(keysigner.RemoteSignerOptions{
+			URL: ts.URL,
+		})
+		if err == nil {
+			t.Fatal("expected error for untrusted certificate")
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ts := newTestRemoteSigner(t, &testSignerAPI{key: key, delay: time.Second})
+
+		rs, err := keysigner.NewRemoteSigner(keysigner.RemoteSignerOptions{
+			URL:        ts.URL,
+			CACertFile: writeCACert(t, ts),
+			Timeout:    100 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = rs.Close() })
+
+		_, err = rs.SignHash(crypto.Keccak256([]byte("test")))
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected deadline exceeded, got %v", err)
+		}
+	})
+}
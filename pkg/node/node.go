@@ -28,12 +28,19 @@ import (
 	"github.com/primevprotocol/mev-commit/pkg/evmclient"
 	"github.com/primevprotocol/mev-commit/pkg/keysigner"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/addressbook"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/blocklist"
 	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/policy"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/reputation"
 	"github.com/primevprotocol/mev-commit/pkg/preconfirmation"
 	bidderapi "github.com/primevprotocol/mev-commit/pkg/rpc/bidder"
 	providerapi "github.com/primevprotocol/mev-commit/pkg/rpc/provider"
 	"github.com/primevprotocol/mev-commit/pkg/signer/preconfsigner"
 	"github.com/primevprotocol/mev-commit/pkg/topology"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
@@ -44,14 +51,26 @@ const (
 	grpcServerDialTimeout = 5 * time.Second
 )
 
+// ErrObserverWithoutGossip is returned for observers which can't follow the
+// commitments because bid broadcast or commitment gossip is disabled.
+var ErrObserverWithoutGossip = errors.New("observers need bid broadcast and commitment gossip enabled")
+
 type Options struct {
 	Version                  string
 	KeySigner                keysigner.KeySigner
+	P2PKeyFile               string
 	Secret                   string
 	PeerType                 string
 	Logger                   *slog.Logger
 	P2PPort                  int
 	P2PAddr                  string
+	P2PListenAddrs           []string
+	ExternalAddrs            []string
+	RelayService             bool
+	StaticRelays             []string
+	HolePunching             bool
+	BidBroadcast             bool
+	GossipCommitments        bool
 	HTTPAddr                 string
 	RPCAddr                  string
 	Bootnodes                []string
@@ -62,10 +81,26 @@ type Options struct {
 	NatAddr                  string
 	TLSCertificateFile       string
 	TLSPrivateKeyFile        string
+	NetworkID                uint64
+	PeerPolicyFile           string
+	BlocklistFile            string
+	AddressBookFile          string
+	PeerStaleAfter           time.Duration
+	StreamRateLimits         map[p2p.PeerType]p2p.RateLimit
+	P2PCompression           []string
+	AllowCIDRs               []string
+	DenyCIDRs                []string
+	DenyPrivateAddrs         bool
+	ProviderSelection        topology.Query
+	MinProviders             int
+	MinBidders               int
+	TracingEndpoint          string
+	TracingFile              string
 }
 
 type Node struct {
-	closers []io.Closer
+	closers    []io.Closer
+	peerPolicy *policy.Policy
 }
 
 func NewNode(opts *Options) (*Node, error) {
@@ -76,6 +111,25 @@ func NewNode(opts *Options) (*Node, error) {
 	srv := apiserver.New(opts.Version, opts.Logger.With("component", "apiserver"))
 	peerType := p2p.FromString(opts.PeerType)
 
+	// Observers follow the commitments on the commitments topic, which only
+	// exists with commitment gossip.
+	if peerType == p2p.PeerTypeObserver && (!opts.BidBroadcast || !opts.GossipCommitments) {
+		return nil, ErrObserverWithoutGossip
+	}
+
+	tracer, err := tracing.New(tracing.Options{
+		Endpoint: opts.TracingEndpoint,
+		File:     opts.TracingFile,
+		Attributes: []attribute.KeyValue{
+			attribute.String("node.address", opts.KeySigner.GetAddress().Hex()),
+			attribute.String("node.type", opts.PeerType),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	nd.closers = append(nd.closers, tracer)
+
 	contractRPC, err := ethclient.Dial(opts.RPCEndpoint)
 	if err != nil {
 		return nil, err
@@ -108,34 +162,119 @@ func NewNode(opts *Options) (*Node, error) {
 		opts.Logger.With("component", "providerregistry"),
 	)
 
+	chainID, err := contractRPC.ChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	identityKey, err := libp2p.LoadOrCreateIdentityKey(opts.P2PKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	peerPolicy, err := policy.New(
+		opts.PeerPolicyFile,
+		opts.Logger.With("component", "peerpolicy"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	nd.peerPolicy = peerPolicy
+
+	bl, err := blocklist.Open(
+		opts.BlocklistFile,
+		opts.Logger.With("component", "blocklist"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := addressbook.Open(
+		opts.AddressBookFile,
+		opts.PeerStaleAfter,
+		opts.Logger.With("component", "addressbook"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	scorer := reputation.New(
+		reputation.DefaultOptions(),
+		opts.Logger.With("component", "reputation"),
+	)
+	nd.closers = append(nd.closers, scorer)
+	srv.RegisterMetricsCollectors(scorer.Metrics()...)
+
 	p2pSvc, err := libp2p.New(&libp2p.Options{
-		KeySigner:      opts.KeySigner,
-		Secret:         opts.Secret,
-		PeerType:       peerType,
-		Register:       providerRegistry,
-		Logger:         opts.Logger.With("component", "p2p"),
-		ListenPort:     opts.P2PPort,
-		ListenAddr:     opts.P2PAddr,
-		MetricsReg:     srv.MetricsRegistry(),
-		BootstrapAddrs: opts.Bootnodes,
-		NatAddr:        opts.NatAddr,
+		KeySigner:        opts.KeySigner,
+		IdentityKey:      identityKey,
+		Secret:           opts.Secret,
+		PeerType:         peerType,
+		Register:         providerRegistry,
+		Logger:           opts.Logger.With("component", "p2p"),
+		ListenPort:       opts.P2PPort,
+		ListenAddr:       opts.P2PAddr,
+		ListenAddrs:      opts.P2PListenAddrs,
+		ExternalAddrs:    opts.ExternalAddrs,
+		RelayService:     opts.RelayService,
+		StaticRelays:     opts.StaticRelays,
+		HolePunching:     opts.HolePunching,
+		PubSub:           opts.BidBroadcast,
+		MetricsReg:       srv.MetricsRegistry(),
+		BootstrapAddrs:   opts.Bootnodes,
+		NatAddr:          opts.NatAddr,
+		ChainID:          chainID,
+		NetworkID:        opts.NetworkID,
+		Version:          opts.Version,
+		Authorizer:       peerPolicy,
+		Blocklist:        bl,
+		AddressBook:      book,
+		Reporter:         scorer,
+		StreamRateLimits: opts.StreamRateLimits,
+		Compression:      opts.P2PCompression,
+		AllowCIDRs:       opts.AllowCIDRs,
+		DenyCIDRs:        opts.DenyCIDRs,
+		DenyPrivateAddrs: opts.DenyPrivateAddrs,
 	})
 	if err != nil {
 		return nil, err
 	}
-	nd.closers = append(nd.closers, p2pSvc)
+	// The address book is closed after the p2p service so that the last
+	// disconnects are saved.
+	nd.closers = append(nd.closers, p2pSvc, book, bl)
+	scorer.SetBlocker(p2pSvc)
 
 	topo := topology.New(p2pSvc, opts.Logger.With("component", "topology"))
 	disc := discovery.New(topo, p2pSvc, opts.Logger.With("component", "discovery_protocol"))
-	nd.closers = append(nd.closers, disc)
+	nd.closers = append(nd.closers, disc, topo)
 
 	srv.RegisterMetricsCollectors(topo.Metrics()...)
+	srv.RegisterMetricsCollectors(disc.Metrics()...)
 
 	// Set the announcer for the topology service
 	topo.SetAnnouncer(disc)
+	topo.SetScorer(scorer)
+	topo.SetStakeReader(providerRegistry)
+	topo.SetLatencyMeter(p2pSvc)
+	disc.SetReporter(scorer)
+	disc.SetAddrFilter(p2pSvc)
 	// Set the notifier for the p2p service
 	p2pSvc.SetNotifier(topo)
 
+	// Bootnodes don't keep connections, bidders and observers connect to the
+	// providers.
+	var targets topology.Targets
+	switch opts.PeerType {
+	case p2p.PeerTypeProvider.String():
+		targets = topology.Targets{Providers: opts.MinProviders, Bidders: opts.MinBidders}
+	case p2p.PeerTypeBidder.String(), p2p.PeerTypeObserver.String():
+		targets = topology.Targets{Providers: opts.MinProviders}
+	}
+	topo.SetTargets(targets)
+	topo.SetDialer(p2pSvc)
+	topo.SetAddressBook(p2pSvc)
+	topo.SetPeerSyncer(disc)
+
 	// Register the discovery protocol with the p2p service
 	p2pSvc.AddStreamHandlers(disc.Streams()...)
 
@@ -158,7 +297,10 @@ func NewNode(opts *Options) (*Node, error) {
 			}
 		}
 
-		grpcServer := grpc.NewServer(grpc.Creds(tlsCredentials))
+		grpcServer := grpc.NewServer(
+			grpc.Creds(tlsCredentials),
+			grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		)
 		preconfSigner := preconfsigner.NewSigner(opts.KeySigner)
 		validator, err := protovalidate.New()
 		if err != nil {
@@ -200,8 +342,15 @@ func NewNode(opts *Options) (*Node, error) {
 				commitmentDA,
 				opts.Logger.With("component", "preconfirmation_protocol"),
 			)
+			preconfProto.SetReporter(scorer)
 			// Only register handler for provider
 			p2pSvc.AddStreamHandlers(preconfProto.Streams()...)
+			if opts.BidBroadcast {
+				preconfProto.EnableBroadcast(p2pSvc, opts.GossipCommitments)
+				if err := p2pSvc.AddTopics(preconfProto.ProviderTopics()...); err != nil {
+					return nil, errors.Join(err, nd.Close())
+				}
+			}
 			srv.RegisterMetricsCollectors(preconfProto.Metrics()...)
 
 		case p2p.PeerTypeBidder.String():
@@ -214,6 +363,15 @@ func NewNode(opts *Options) (*Node, error) {
 				commitmentDA,
 				opts.Logger.With("component", "preconfirmation_protocol"),
 			)
+			preconfProto.SetReporter(scorer)
+			preconfProto.SetProviderSelection(opts.ProviderSelection)
+			if opts.BidBroadcast {
+				preconfProto.EnableBroadcast(p2pSvc, opts.GossipCommitments)
+				p2pSvc.AddStreamHandlers(preconfProto.BidderStreams()...)
+				if err := p2pSvc.AddTopics(preconfProto.BidderTopics()...); err != nil {
+					return nil, errors.Join(err, nd.Close())
+				}
+			}
 			srv.RegisterMetricsCollectors(preconfProto.Metrics()...)
 
 			bidderAPI := bidderapi.NewService(
@@ -225,6 +383,27 @@ func NewNode(opts *Options) (*Node, error) {
 			)
 			bidderapiv1.RegisterBidderServer(grpcServer, bidderAPI)
 			srv.RegisterMetricsCollectors(bidderAPI.Metrics()...)
+
+		case p2p.PeerTypeObserver.String():
+			// Observers only verify and follow the gossiped commitments, they
+			// don't get the bidder API and can't send bids. The observed
+			// commitments are exposed by the debug API.
+			preconfProto := preconfirmation.New(
+				topo,
+				p2pSvc,
+				preconfSigner,
+				bidderRegistry,
+				bidProcessor,
+				commitmentDA,
+				opts.Logger.With("component", "preconfirmation_protocol"),
+			)
+			preconfProto.SetReporter(scorer)
+			preconfProto.EnableBroadcast(p2pSvc, opts.GossipCommitments)
+			if err := p2pSvc.AddTopics(preconfProto.ObserverTopics()...); err != nil {
+				return nil, errors.Join(err, nd.Close())
+			}
+			debugapi.RegisterObserverAPI(srv, preconfProto, opts.Logger.With("component", "observerapi"))
+			srv.RegisterMetricsCollectors(preconfProto.Metrics()...)
 		}
 
 		started := make(chan struct{})
@@ -264,6 +443,7 @@ func NewNode(opts *Options) (*Node, error) {
 				opts.RPCAddr,
 				grpc.WithBlock(),
 				grpc.WithTransportCredentials(e.credential),
+				grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 			)
 			if err != nil {
 				opts.Logger.Error("failed to dial grpc server", "error", err)
@@ -315,6 +495,13 @@ func NewNode(opts *Options) (*Node, error) {
 		)
 	}
 
+	// Connect to peers only once all protocols are registered, peers keep
+	// the protocols announced in the handshake. Bootnodes are dialed by the
+	// p2p service, the peers from the previous run by discovery.
+	p2pSvc.Start()
+	disc.Reconnect(p2pSvc.KnownPeers())
+	topo.Start()
+
 	server := &http.Server{
 		Addr:    opts.HTTPAddr,
 		Handler: srv.Router(),
@@ -343,6 +530,12 @@ func NewNode(opts *Options) (*Node, error) {
 	return nd, nil
 }
 
+// ReloadPeerPolicy reads the peer policy file again. Connected peers are not
+// affected, the new policy applies to the following handshakes.
+func (n *Node) ReloadPeerPolicy() error {
+	return n.peerPolicy.Reload()
+}
+
 func (n *Node) Close() error {
 	var err error
 	for _, c := range n.closers {
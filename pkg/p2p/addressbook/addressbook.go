@@ -0,0 +1,229 @@
+package addressbook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/util"
+)
+
+// Entry is a peer which was connected before.
+type Entry struct {
+	EthAddress common.Address `json:"eth_address"`
+	PeerID     string         `json:"peer_id"`
+	Type       string         `json:"type"`
+	// Underlay is the address info used to dial the peer.
+	Underlay json.RawMessage `json:"underlay,omitempty"`
+	LastSeen time.Time       `json:"last_seen"`
+}
+
+const (
+	// defaultMaxEntries is the number of peers kept, the least recently seen
+	// peers are evicted first.
+	defaultMaxEntries = 1000
+	// saveDelay batches the changes written to the file.
+	saveDelay = 5 * time.Second
+)
+
+// Book keeps the peers which were connected before so that they can be
+// dialed again after a restart. If it is backed by a file, the changes are
+// written to it in batches and when the book is closed.
+type Book struct {
+	path       string
+	staleAfter time.Duration
+	maxEntries int
+	logger     *slog.Logger
+	mu         sync.Mutex
+	entries    map[common.Address]Entry
+	now        func() time.Time
+	// saveTimer is the pending save, it is nil if there is none.
+	saveTimer *time.Timer
+	closed    bool
+	// saveMu serializes the writes to the file.
+	saveMu sync.Mutex
+}
+
+// Open loads the address book from the file at path. Peers which were not
+// seen for longer than staleAfter are removed, they are kept forever if it is
+// zero. If path is empty, the address book is only kept in memory.
+func Open(path string, staleAfter time.Duration, logger *slog.Logger) (*Book, error) {
+	b := &Book{
+		staleAfter: staleAfter,
+		maxEntries: defaultMaxEntries,
+		logger:     logger,
+		entries:    make(map[common.Address]Entry),
+		now:        time.Now,
+	}
+	if path == "" {
+		return b, nil
+	}
+
+	path, err := util.ResolveFilePath(path)
+	if err != nil {
+		return nil, err
+	}
+	b.path = path
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return b, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to read address book: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse address book: %w", err)
+	}
+	for _, e := range entries {
+		b.entries[e.EthAddress] = e
+	}
+	b.prune()
+	b.evict()
+	return b, nil
+}
+
+func (b *Book) stale(e Entry, now time.Time) bool {
+	return b.staleAfter > 0 && now.Sub(e.LastSeen) > b.staleAfter
+}
+
+func (b *Book) prune() {
+	now := b.now()
+	for addr, e := range b.entries {
+		if b.stale(e, now) {
+			delete(b.entries, addr)
+		}
+	}
+}
+
+// evict removes the least recently seen peers above the maximum number of
+// entries.
+func (b *Book) evict() {
+	for len(b.entries) > b.maxEntries {
+		var (
+			oldest common.Address
+			seen   time.Time
+		)
+		for addr, e := range b.entries {
+			if seen.IsZero() || e.LastSeen.Before(seen) {
+				oldest, seen = addr, e.LastSeen
+			}
+		}
+		delete(b.entries, oldest)
+	}
+}
+
+// Put records that the peer was seen now. The known underlay is kept if the
+// entry has none.
+func (b *Book) Put(e Entry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(e.Underlay) == 0 {
+		if prev, ok := b.entries[e.EthAddress]; ok && prev.PeerID == e.PeerID {
+			e.Underlay = prev.Underlay
+		}
+	}
+	e.LastSeen = b.now()
+	b.entries[e.EthAddress] = e
+
+	b.prune()
+	b.evict()
+	b.scheduleSave()
+}
+
+// Entries returns the peers which are not stale, most recently seen first.
+func (b *Book) Entries() []Entry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	res := make([]Entry, 0, len(b.entries))
+	for _, e := range b.entries {
+		if !b.stale(e, now) {
+			res = append(res, e)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].LastSeen.After(res[j].LastSeen)
+	})
+	return res
+}
+
+// Close writes the pending changes to the file. Later changes are only kept
+// in memory.
+func (b *Book) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	if b.saveTimer != nil {
+		b.saveTimer.Stop()
+		b.saveTimer = nil
+	}
+	b.mu.Unlock()
+
+	return b.save()
+}
+
+// scheduleSave saves the address book after saveDelay unless a save is
+// already pending. It has to be called with the lock held.
+func (b *Book) scheduleSave() {
+	if b.path == "" || b.closed || b.saveTimer != nil {
+		return
+	}
+	b.saveTimer = time.AfterFunc(saveDelay, func() {
+		if err := b.save(); err != nil {
+			b.logger.Error("failed to save address book", "path", b.path, "err", err)
+		}
+	})
+}
+
+// save writes the address book to a temporary file which replaces the previous one
+// so that a crash never leaves a partially written file. The file is written
+// without holding the lock of the entries.
+func (b *Book) save() error {
+	if b.path == "" {
+		return nil
+	}
+
+	b.saveMu.Lock()
+	defer b.saveMu.Unlock()
+
+	b.mu.Lock()
+	b.saveTimer = nil
+	entries := make([]Entry, 0, len(b.entries))
+	for _, e := range b.entries {
+		entries = append(entries, e)
+	}
+	b.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LastSeen.After(entries[j].LastSeen)
+	})
+
+	// The underlays are passed on as they are, so they are not indented.
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(b.path), 0700); err != nil {
+		return fmt.Errorf("failed to create address book directory: %w", err)
+	}
+	tmp := b.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write address book: %w", err)
+	}
+	if err := os.Rename(tmp, b.path); err != nil {
+		return fmt.Errorf("failed to write address book: %w", err)
+	}
+	return nil
+}
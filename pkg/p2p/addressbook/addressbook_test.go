@@ -0,0 +1,120 @@
+package addressbook_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/addressbook"
+)
+
+func TestAddressBook(t *testing.T) {
+	t.Parallel()
+
+	address1 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	address2 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	underlay := json.RawMessage(`{"ID":"peer1","Addrs":["/ip4/1.2.3.4/tcp/13522"]}`)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("persisted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "address_book.json")
+
+		b, err := addressbook.Open(path, time.Hour, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		b.SetNow(func() time.Time { return now })
+
+		b.Put(addressbook.Entry{EthAddress: address1, PeerID: "peer1", Type: "provider", Underlay: underlay})
+		now = now.Add(time.Minute)
+		b.Put(addressbook.Entry{EthAddress: address2, PeerID: "peer2", Type: "bidder", Underlay: underlay})
+
+		// The changes are saved in batches.
+		if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("expected address book to be saved later, got %v", err)
+		}
+		if err := b.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		b, err = addressbook.Open(path, time.Hour, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries := b.Entries()
+		if len(entries) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(entries))
+		}
+		if entries[0].EthAddress != address2 || entries[1].EthAddress != address1 {
+			t.Fatal("expected most recently seen peer first")
+		}
+		if string(entries[1].Underlay) != string(underlay) {
+			t.Fatalf("unexpected underlay %s", entries[1].Underlay)
+		}
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		b, err := addressbook.Open("", time.Hour, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		b.SetNow(func() time.Time { return now })
+
+		b.Put(addressbook.Entry{EthAddress: address1, PeerID: "peer1", Underlay: underlay})
+		now = now.Add(2 * time.Hour)
+		if len(b.Entries()) != 0 {
+			t.Fatal("expected stale entry to be removed")
+		}
+
+		b.Put(addressbook.Entry{EthAddress: address2, PeerID: "peer2", Underlay: underlay})
+		if len(b.Entries()) != 1 {
+			t.Fatalf("expected 1 entry, got %d", len(b.Entries()))
+		}
+	})
+
+	t.Run("max entries", func(t *testing.T) {
+		b, err := addressbook.Open("", 0, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.SetMaxEntries(1)
+		now := time.Now()
+		b.SetNow(func() time.Time { return now })
+
+		b.Put(addressbook.Entry{EthAddress: address1, PeerID: "peer1", Underlay: underlay})
+		now = now.Add(time.Minute)
+		b.Put(addressbook.Entry{EthAddress: address2, PeerID: "peer2", Underlay: underlay})
+
+		entries := b.Entries()
+		if len(entries) != 1 || entries[0].EthAddress != address2 {
+			t.Fatalf("expected least recently seen peer to be evicted, got %+v", entries)
+		}
+	})
+
+	t.Run("keep underlay", func(t *testing.T) {
+		b, err := addressbook.Open("", 0, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b.Put(addressbook.Entry{EthAddress: address1, PeerID: "peer1", Underlay: underlay})
+		b.Put(addressbook.Entry{EthAddress: address1, PeerID: "peer1"})
+		if string(b.Entries()[0].Underlay) != string(underlay) {
+			t.Fatal("expected underlay to be kept")
+		}
+
+		// A new peer ID invalidates the old addresses.
+		b.Put(addressbook.Entry{EthAddress: address1, PeerID: "peer3"})
+		if len(b.Entries()[0].Underlay) != 0 {
+			t.Fatal("expected underlay to be dropped")
+		}
+	})
+}
@@ -0,0 +1,11 @@
+package addressbook
+
+import "time"
+
+func (b *Book) SetNow(now func() time.Time) {
+	b.now = now
+}
+
+func (b *Book) SetMaxEntries(n int) {
+	b.maxEntries = n
+}
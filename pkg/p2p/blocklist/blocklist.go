@@ -0,0 +1,300 @@
+package blocklist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/util"
+)
+
+const (
+	// maxHistory is the number of events kept in the history.
+	maxHistory = 1000
+	// saveDelay batches the changes written to the file.
+	saveDelay = time.Second
+)
+
+var (
+	ErrNotFound     = errors.New("peer is not blocked")
+	ErrInvalidEntry = errors.New("entry needs either a peer ID or an ethereum address")
+)
+
+// Entry is a blocked peer. Peers are identified either by their libp2p peer
+// ID or by their Ethereum address.
+type Entry struct {
+	PeerID     string         `json:"peer_id,omitempty"`
+	EthAddress common.Address `json:"eth_address"`
+	Reason     string         `json:"reason"`
+	// Manual is set for entries added by an operator.
+	Manual bool      `json:"manual"`
+	Start  time.Time `json:"start"`
+	// Duration of the block, the peer is blocked forever if it is zero.
+	Duration time.Duration `json:"duration"`
+}
+
+// Key returns the identifier of the blocked peer.
+func (e Entry) Key() string {
+	if e.PeerID != "" {
+		return e.PeerID
+	}
+	return e.EthAddress.Hex()
+}
+
+// Remaining returns the remaining duration of the block. It is zero for
+// entries which never expire.
+func (e Entry) Remaining(now time.Time) time.Duration {
+	if e.Duration == 0 {
+		return 0
+	}
+	return e.Start.Add(e.Duration).Sub(now)
+}
+
+func (e Entry) expired(now time.Time) bool {
+	return e.Duration != 0 && !now.Before(e.Start.Add(e.Duration))
+}
+
+type Action string
+
+const (
+	ActionBlock   Action = "block"
+	ActionUnblock Action = "unblock"
+)
+
+// Event is a change of the blocklist.
+type Event struct {
+	Action Action    `json:"action"`
+	Time   time.Time `json:"time"`
+	Entry  Entry     `json:"entry"`
+}
+
+type state struct {
+	Entries []Entry `json:"entries"`
+	History []Event `json:"history"`
+}
+
+// Blocklist keeps the blocked peers and the history of changes. If it is
+// backed by a file, the changes are written to it in batches and when the
+// blocklist is closed so that the blocklist survives restarts.
+type Blocklist struct {
+	path    string
+	logger  *slog.Logger
+	mu      sync.Mutex
+	entries map[string]Entry
+	history []Event
+	now     func() time.Time
+	// saveTimer is the pending save, it is nil if there is none.
+	saveTimer *time.Timer
+	closed    bool
+	// saveMu serializes the writes to the file.
+	saveMu sync.Mutex
+}
+
+// Open loads the blocklist from the file at path. The file is created on the
+// first change if it does not exist. If path is empty, the blocklist is only
+// kept in memory.
+func Open(path string, logger *slog.Logger) (*Blocklist, error) {
+	b := &Blocklist{
+		logger:  logger,
+		entries: make(map[string]Entry),
+		now:     time.Now,
+	}
+	if path == "" {
+		return b, nil
+	}
+
+	path, err := util.ResolveFilePath(path)
+	if err != nil {
+		return nil, err
+	}
+	b.path = path
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return b, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to read blocklist: %w", err)
+	}
+
+	var st state
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("failed to parse blocklist: %w", err)
+	}
+
+	now := b.now()
+	for _, e := range st.Entries {
+		if !e.expired(now) {
+			b.entries[e.Key()] = e
+		}
+	}
+	b.history = st.History
+	return b, nil
+}
+
+// Block adds the entry to the blocklist, replacing an existing entry for the
+// same peer. The start of the entry is set to the current time.
+func (b *Blocklist) Block(e Entry) error {
+	if e.PeerID == "" && e.EthAddress == (common.Address{}) {
+		return ErrInvalidEntry
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e.Start = b.now()
+	b.entries[e.Key()] = e
+	b.record(ActionBlock, e)
+	b.scheduleSave()
+	return nil
+}
+
+// Unblock removes the peer identified by key from the blocklist.
+func (b *Blocklist) Unblock(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.entries[key]
+	if !ok || e.expired(b.now()) {
+		return ErrNotFound
+	}
+
+	delete(b.entries, key)
+	b.record(ActionUnblock, e)
+	b.scheduleSave()
+	return nil
+}
+
+// IsBlocked returns true if the peer identified by key is blocked.
+func (b *Blocklist) IsBlocked(key string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.entries[key]
+	if !ok {
+		return false
+	}
+	if e.expired(b.now()) {
+		delete(b.entries, key)
+		return false
+	}
+	return true
+}
+
+// Entries returns the blocked peers ordered by the start of the block.
+func (b *Blocklist) Entries() []Entry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	res := make([]Entry, 0, len(b.entries))
+	for _, e := range b.entries {
+		if !e.expired(now) {
+			res = append(res, e)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Start.Before(res[j].Start)
+	})
+	return res
+}
+
+// History returns the changes of the blocklist, oldest first.
+func (b *Blocklist) History() []Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := make([]Event, len(b.history))
+	copy(res, b.history)
+	return res
+}
+
+func (b *Blocklist) record(action Action, e Entry) {
+	b.history = append(b.history, Event{
+		Action: action,
+		Time:   b.now(),
+		Entry:  e,
+	})
+	if len(b.history) > maxHistory {
+		b.history = b.history[len(b.history)-maxHistory:]
+	}
+}
+
+// Close writes the pending changes to the file. Later changes are only kept
+// in memory.
+func (b *Blocklist) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	if b.saveTimer != nil {
+		b.saveTimer.Stop()
+		b.saveTimer = nil
+	}
+	b.mu.Unlock()
+
+	return b.save()
+}
+
+// scheduleSave saves the blocklist after saveDelay unless a save is already
+// pending. It has to be called with the lock held.
+func (b *Blocklist) scheduleSave() {
+	if b.path == "" || b.closed || b.saveTimer != nil {
+		return
+	}
+	b.saveTimer = time.AfterFunc(saveDelay, func() {
+		if err := b.save(); err != nil {
+			b.logger.Error("failed to save blocklist", "path", b.path, "err", err)
+		}
+	})
+}
+
+// save writes the blocklist to a temporary file which replaces the previous
+// one so that a crash never leaves a partially written file. The file is
+// written without holding the lock, which the connection gater takes for
+// every dial and accept.
+func (b *Blocklist) save() error {
+	if b.path == "" {
+		return nil
+	}
+
+	b.saveMu.Lock()
+	defer b.saveMu.Unlock()
+
+	b.mu.Lock()
+	b.saveTimer = nil
+	now := b.now()
+	st := state{
+		Entries: make([]Entry, 0, len(b.entries)),
+		History: make([]Event, len(b.history)),
+	}
+	for _, e := range b.entries {
+		if !e.expired(now) {
+			st.Entries = append(st.Entries, e)
+		}
+	}
+	copy(st.History, b.history)
+	b.mu.Unlock()
+
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(b.path), 0700); err != nil {
+		return fmt.Errorf("failed to create blocklist directory: %w", err)
+	}
+	tmp := b.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write blocklist: %w", err)
+	}
+	if err := os.Rename(tmp, b.path); err != nil {
+		return fmt.Errorf("failed to write blocklist: %w", err)
+	}
+	return nil
+}
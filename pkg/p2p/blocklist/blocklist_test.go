@@ -0,0 +1,105 @@
+package blocklist_test
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/blocklist"
+)
+
+func TestBlocklist(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("persisted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "blocklist.json")
+
+		b, err := blocklist.Open(path, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = b.Block(blocklist.Entry{PeerID: "peer1", Reason: "test"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = b.Block(blocklist.Entry{EthAddress: address, Reason: "test", Manual: true, Duration: time.Hour})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = b.Block(blocklist.Entry{PeerID: "peer2", Reason: "test", Duration: time.Hour})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Unblock("peer2"); err != nil {
+			t.Fatal(err)
+		}
+
+		// The changes are saved in batches.
+		if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("expected blocklist to be saved later, got %v", err)
+		}
+		if err := b.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		b, err = blocklist.Open(path, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !b.IsBlocked("peer1") || !b.IsBlocked(address.Hex()) {
+			t.Fatal("expected peers to be blocked after reopening")
+		}
+		if b.IsBlocked("peer2") {
+			t.Fatal("expected peer2 to be unblocked")
+		}
+		if len(b.Entries()) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(b.Entries()))
+		}
+
+		history := b.History()
+		if len(history) != 4 {
+			t.Fatalf("expected 4 events, got %d", len(history))
+		}
+		last := history[3]
+		if last.Action != blocklist.ActionUnblock || last.Entry.PeerID != "peer2" {
+			t.Fatalf("unexpected event %+v", last)
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		b, err := blocklist.Open("", logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = b.Block(blocklist.Entry{PeerID: "peer1", Duration: time.Millisecond})
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if b.IsBlocked("peer1") {
+			t.Fatal("expected entry to expire")
+		}
+		if err := b.Unblock("peer1"); !errors.Is(err, blocklist.ErrNotFound) {
+			t.Fatalf("expected error %v, got %v", blocklist.ErrNotFound, err)
+		}
+	})
+
+	t.Run("invalid entry", func(t *testing.T) {
+		b, err := blocklist.Open("", logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Block(blocklist.Entry{}); !errors.Is(err, blocklist.ErrInvalidEntry) {
+			t.Fatalf("expected error %v, got %v", blocklist.ErrInvalidEntry, err)
+		}
+	})
+}
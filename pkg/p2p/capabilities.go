@@ -0,0 +1,52 @@
+package p2p
+
+import (
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// ProtocolInfo identifies a stream protocol by name and semantic version.
+type ProtocolInfo struct {
+	Name    string
+	Version string
+}
+
+// Capabilities are exchanged with the peer during the handshake.
+type Capabilities struct {
+	// Version is the version of the node software.
+	Version   string
+	ChainID   uint64
+	Protocols []ProtocolInfo
+	// Features are optional behaviours supported by the node.
+	Features []string
+}
+
+// SupportsProtocol reports whether the peer accepts streams of the protocol
+// with the given version. The same rules as for the stream handlers apply:
+// the major versions have to match and the peer has to support at least the
+// minor version.
+func (c *Capabilities) SupportsProtocol(name, version string) bool {
+	want, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	for _, p := range c.Protocols {
+		if p.Name != name {
+			continue
+		}
+		have, err := semver.NewVersion(p.Version)
+		if err != nil {
+			continue
+		}
+		if have.Major() == want.Major() && have.Minor() >= want.Minor() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFeature reports whether the peer advertised the feature.
+func (c *Capabilities) HasFeature(feature string) bool {
+	return slices.Contains(c.Features, feature)
+}
@@ -0,0 +1,212 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Standard header keys. The streamer sets them on every outbound stream, the
+// response header echoes the request ID.
+const (
+	// HeaderRequestID identifies the request in the logs of both peers.
+	HeaderRequestID = "request-id"
+	// HeaderClientVersion is the software version of the peer which opened
+	// the stream.
+	HeaderClientVersion = "client-version"
+	// HeaderDeadline is the time the peer still waits for the response
+	// when it sends the header, as a duration like "1.5s". It is relative so
+	// that the deadline doesn't depend on the clocks of the peers.
+	HeaderDeadline = "deadline"
+	// HeaderAcceptEncoding lists the message encodings the peer can read in
+	// order of preference.
+	HeaderAcceptEncoding = "accept-encoding"
+	// HeaderContentEncoding is the encoding of the messages in both
+	// directions which the handler picked from the accepted encodings.
+	HeaderContentEncoding = "content-encoding"
+	// HeaderIdempotencyKey is equal for retries of the same request, so
+	// that the handler can detect duplicates.
+	HeaderIdempotencyKey = "idempotency-key"
+)
+
+// Message encodings. EncodingIdentity is the encoding of uncompressed
+// messages, which every peer can read.
+const (
+	EncodingIdentity = "identity"
+	EncodingSnappy   = "snappy"
+	EncodingZstd     = "zstd"
+)
+
+var ErrInvalidHeader = errors.New("invalid header")
+
+func (h Header) stringValue(key string) string {
+	return h[key].GetStringValue()
+}
+
+func (h Header) RequestID() string {
+	return h.stringValue(HeaderRequestID)
+}
+
+func (h Header) SetRequestID(id string) {
+	h[HeaderRequestID] = structpb.NewStringValue(id)
+}
+
+func (h Header) ClientVersion() string {
+	return h.stringValue(HeaderClientVersion)
+}
+
+func (h Header) SetClientVersion(version string) {
+	h[HeaderClientVersion] = structpb.NewStringValue(version)
+}
+
+// Timeout returns the remaining time of the request when the header was
+// sent, if it is set and valid.
+func (h Header) Timeout() (time.Duration, bool) {
+	v := h.stringValue(HeaderDeadline)
+	if v == "" {
+		return 0, false
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, false
+	}
+	return d, true
+}
+
+// Deadline returns the deadline of the request on the local clock, assuming
+// that the header was just received.
+func (h Header) Deadline() (time.Time, bool) {
+	d, ok := h.Timeout()
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Now().Add(d), true
+}
+
+// SetDeadline sets the remaining time until the deadline. Deadlines which
+// passed already are sent as a zero timeout.
+func (h Header) SetDeadline(t time.Time) {
+	h[HeaderDeadline] = structpb.NewStringValue(max(time.Until(t), 0).String())
+}
+
+func (h Header) AcceptEncodings() []string {
+	values := h[HeaderAcceptEncoding].GetListValue().GetValues()
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		res = append(res, v.GetStringValue())
+	}
+	return res
+}
+
+func (h Header) SetAcceptEncodings(encodings ...string) {
+	values := make([]*structpb.Value, 0, len(encodings))
+	for _, e := range encodings {
+		values = append(values, structpb.NewStringValue(e))
+	}
+	h[HeaderAcceptEncoding] = structpb.NewListValue(&structpb.ListValue{Values: values})
+}
+
+// ContentEncoding returns the encoding of the messages, the messages are
+// not compressed if it is not set.
+func (h Header) ContentEncoding() string {
+	if e := h.stringValue(HeaderContentEncoding); e != "" {
+		return e
+	}
+	return EncodingIdentity
+}
+
+func (h Header) SetContentEncoding(encoding string) {
+	h[HeaderContentEncoding] = structpb.NewStringValue(encoding)
+}
+
+func (h Header) IdempotencyKey() string {
+	return h.stringValue(HeaderIdempotencyKey)
+}
+
+func (h Header) SetIdempotencyKey(key string) {
+	h[HeaderIdempotencyKey] = structpb.NewStringValue(key)
+}
+
+// Validate checks the standard headers which are present. Headers of peers
+// which don't send them are valid.
+func (h Header) Validate() error {
+	for _, key := range []string{
+		HeaderRequestID,
+		HeaderClientVersion,
+		HeaderDeadline,
+		HeaderContentEncoding,
+		HeaderIdempotencyKey,
+	} {
+		v, found := h[key]
+		if !found {
+			continue
+		}
+		if _, ok := v.GetKind().(*structpb.Value_StringValue); !ok {
+			return fmt.Errorf("%w: %s is not a string", ErrInvalidHeader, key)
+		}
+	}
+	if _, found := h[HeaderDeadline]; found {
+		if d, ok := h.Timeout(); !ok || d < 0 {
+			return fmt.Errorf("%w: malformed %s", ErrInvalidHeader, HeaderDeadline)
+		}
+	}
+	if v, found := h[HeaderAcceptEncoding]; found {
+		list := v.GetListValue()
+		if list == nil {
+			return fmt.Errorf("%w: %s is not a list", ErrInvalidHeader, HeaderAcceptEncoding)
+		}
+		for _, e := range list.GetValues() {
+			if _, ok := e.GetKind().(*structpb.Value_StringValue); !ok {
+				return fmt.Errorf("%w: %s is not a list of strings", ErrInvalidHeader, HeaderAcceptEncoding)
+			}
+		}
+	}
+	return nil
+}
+
+type (
+	requestIDKey   struct{}
+	idempotencyKey struct{}
+	headerKey      struct{}
+)
+
+// WithRequestID returns a copy of ctx with the request ID. Streams opened
+// with the context carry it instead of a new one, handlers receive the ID of
+// the request in their context.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID of ctx, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// WithIdempotencyKey returns a copy of ctx with the idempotency key which is
+// sent with the streams opened with the context.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key of ctx, if any.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return key
+}
+
+// ContextWithHeader returns a copy of ctx with the header of the stream the
+// handler is called for.
+func ContextWithHeader(ctx context.Context, hdr Header) context.Context {
+	return context.WithValue(ctx, headerKey{}, hdr)
+}
+
+// HeaderFromContext returns the header of the stream in the context of a
+// handler, e.g. to read the idempotency key of the request.
+func HeaderFromContext(ctx context.Context) Header {
+	hdr, _ := ctx.Value(headerKey{}).(Header)
+	return hdr
+}
@@ -0,0 +1,62 @@
+package libp2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/addressbook"
+)
+
+// recordPeer adds a connected provider or bidder to the address book.
+// Bootnodes are configured and don't need to be remembered.
+func (s *Service) recordPeer(peerID peer.ID, p p2p.Peer) {
+	if p.Type != p2p.PeerTypeProvider && p.Type != p2p.PeerTypeBidder {
+		return
+	}
+
+	entry := addressbook.Entry{
+		EthAddress: p.EthAddress,
+		PeerID:     peerID.String(),
+		Type:       p.Type.String(),
+	}
+	if addrs := s.host.Peerstore().Addrs(peerID); len(addrs) > 0 {
+		underlay, err := peer.AddrInfo{ID: peerID, Addrs: addrs}.MarshalJSON()
+		if err != nil {
+			s.logger.Error("failed to marshal peer addresses", "peer", p, "err", err)
+		} else {
+			entry.Underlay = underlay
+		}
+	}
+
+	s.addressBook.Put(entry)
+}
+
+// KnownPeers returns the peers from the address book which are not connected,
+// most recently seen first.
+func (s *Service) KnownPeers() []p2p.PeerInfo {
+	return s.knownPeers(func(addressbook.Entry) bool { return true })
+}
+
+// KnownPeersOf returns the known peers of the type which are not connected,
+// most recently seen first.
+func (s *Service) KnownPeersOf(peerType p2p.PeerType) []p2p.PeerInfo {
+	return s.knownPeers(func(e addressbook.Entry) bool {
+		return e.Type == peerType.String()
+	})
+}
+
+func (s *Service) knownPeers(filter func(addressbook.Entry) bool) []p2p.PeerInfo {
+	var res []p2p.PeerInfo
+	for _, e := range s.addressBook.Entries() {
+		if len(e.Underlay) == 0 || !filter(e) {
+			continue
+		}
+		if _, connected := s.peers.getPeerID(e.EthAddress); connected {
+			continue
+		}
+		res = append(res, p2p.PeerInfo{
+			EthAddress: e.EthAddress,
+			Underlay:   e.Underlay,
+		})
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+package libp2p
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+)
+
+// addrFilter decides which addresses are dialed and accepted.
+type addrFilter struct {
+	allow []*net.IPNet
+	deny  []*net.IPNet
+	// denyPrivate rejects dials to private, loopback and unroutable
+	// addresses.
+	denyPrivate bool
+}
+
+func newAddrFilter(allow, deny []string, denyPrivate bool) (*addrFilter, error) {
+	allowNets, err := parseCIDRs(allow)
+	if err != nil {
+		return nil, err
+	}
+	denyNets, err := parseCIDRs(deny)
+	if err != nil {
+		return nil, err
+	}
+	return &addrFilter{
+		allow:       allowNets,
+		deny:        denyNets,
+		denyPrivate: denyPrivate,
+	}, nil
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, c := range cidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", c, err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+func contains(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowIP applies the allow and deny lists.
+func (f *addrFilter) allowIP(ip net.IP) bool {
+	if contains(f.deny, ip) {
+		return false
+	}
+	return len(f.allow) == 0 || contains(f.allow, ip)
+}
+
+// allowAccept returns false for connections from denied ranges.
+func (f *addrFilter) allowAccept(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	return f.allowIP(ip)
+}
+
+// allowDial returns false for addresses which must not be dialed. Addresses
+// without an IP, e.g. DNS addresses, are checked again once resolved.
+func (f *addrFilter) allowDial(addr multiaddr.Multiaddr) bool {
+	if f.denyPrivate && !manet.IsPublicAddr(addr) {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return true
+	}
+	return f.allowIP(ip)
+}
+
+func (f *addrFilter) filterDialable(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	res := make([]multiaddr.Multiaddr, 0, len(addrs))
+	for _, addr := range addrs {
+		if f.allowDial(addr) {
+			res = append(res, addr)
+		}
+	}
+	return res
+}
+
+// FilterUnderlay removes the addresses which must not be dialed from an
+// underlay announced by another peer. It returns p2p.ErrNoAddresses if no
+// address is left.
+func (s *Service) FilterUnderlay(underlay []byte) ([]byte, error) {
+	var addrInfo peer.AddrInfo
+	if err := addrInfo.UnmarshalJSON(underlay); err != nil {
+		return nil, err
+	}
+
+	addrInfo.Addrs = s.addrFilter.filterDialable(addrInfo.Addrs)
+	if len(addrInfo.Addrs) == 0 {
+		return nil, p2p.ErrNoAddresses
+	}
+	return addrInfo.MarshalJSON()
+}
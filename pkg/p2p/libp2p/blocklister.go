@@ -1,68 +1,169 @@
 package libp2p
 
 import (
+	"errors"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	core "github.com/libp2p/go-libp2p/core"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/blocklist"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p/internal/handshake"
 )
 
-type blockInfo struct {
-	reason   string
-	start    time.Time
-	duration time.Duration
-}
+var (
+	errPeerExists  = errors.New("peer already exists")
+	errPeerBlocked = errors.New("peer is blocklisted")
+
+	ErrInvalidPeer = errors.New("peer has to be an ethereum address or a peer ID")
+)
 
 func (s *Service) blockPeer(peer core.PeerID, dur time.Duration, reason string) {
-	s.blockMu.Lock()
-	defer s.blockMu.Unlock()
+	err := s.blocklist.Block(blocklist.Entry{
+		PeerID:   peer.String(),
+		Reason:   reason,
+		Duration: dur,
+	})
+	if err != nil {
+		s.logger.Error("failed to block peer", "peer", peer, "err", err)
+	}
+}
 
-	s.blockMap[peer] = blockInfo{
-		reason:   reason,
-		start:    time.Now(),
-		duration: dur,
+// blockFailedHandshake blocks a peer which failed the verification in the
+// handshake. Peers which fail to prove their identity are blocked forever,
+// all other failures only block the peer for some time so that it can't keep
+// retrying. Transient failures like timeouts or I/O errors, duplicate
+// connections and policy rejections don't block the peer, the policy is
+// checked on every handshake so that a reload takes effect immediately.
+func (s *Service) blockFailedHandshake(peer core.PeerID, err error) {
+	switch {
+	case errors.Is(err, handshake.ErrSignatureVerificationFailed):
+		s.blockPeer(peer, 0, "signature verification failed")
+	case errors.Is(err, handshake.ErrInvalidPeerIDBinding):
+		s.blockPeer(peer, 0, "invalid peer ID binding during handshake")
+	case errors.Is(err, handshake.ErrInvalidPeerRecord):
+		s.blockPeer(peer, time.Hour, "invalid peer record during handshake")
+	case errors.Is(err, handshake.ErrInvalidNonce):
+		s.blockPeer(peer, 0, "invalid nonce during handshake")
+	case errors.Is(err, handshake.ErrChainIDMismatch):
+		s.blockPeer(peer, time.Hour, "chain ID mismatch")
+	case errors.Is(err, handshake.ErrNetworkIDMismatch):
+		s.blockPeer(peer, time.Hour, "network ID mismatch")
+	case errors.Is(err, handshake.ErrIncompatibleProtocol):
+		s.blockPeer(peer, 10*time.Minute, "incompatible protocol version")
+	case errors.Is(err, handshake.ErrInsufficientStake):
+		s.blockPeer(peer, 5*time.Minute, "insufficient stake")
 	}
 }
 
 func (s *Service) isBlocked(peer core.PeerID) bool {
-	s.blockMu.Lock()
-	defer s.blockMu.Unlock()
+	return s.blocklist.IsBlocked(peer.String())
+}
+
+// isAddressBlocked is checked once the handshake has revealed the ethereum
+// address of the peer.
+func (s *Service) isAddressBlocked(ethAddress common.Address) bool {
+	return s.blocklist.IsBlocked(ethAddress.Hex())
+}
+
+// parsePeer parses an ethereum address or a peer ID.
+func parsePeer(id string) (blocklist.Entry, error) {
+	if common.IsHexAddress(id) {
+		return blocklist.Entry{EthAddress: common.HexToAddress(id)}, nil
+	}
+	peerID, err := peer.Decode(id)
+	if err != nil {
+		return blocklist.Entry{}, ErrInvalidPeer
+	}
+	return blocklist.Entry{PeerID: peerID.String()}, nil
+}
+
+// Block blocks the peer identified by an ethereum address or a peer ID on
+// behalf of an operator and disconnects it if it is connected. The peer is
+// blocked forever if the duration is zero.
+func (s *Service) Block(id string, dur time.Duration, reason string) error {
+	entry, err := parsePeer(id)
+	if err != nil {
+		return err
+	}
+	entry.Reason = reason
+	entry.Duration = dur
+	entry.Manual = true
+	return s.block(entry)
+}
+
+// BlockAddress blocks the peer with the ethereum address and disconnects it
+// if it is connected.
+func (s *Service) BlockAddress(ethAddress common.Address, dur time.Duration, reason string) error {
+	return s.block(blocklist.Entry{
+		EthAddress: ethAddress,
+		Reason:     reason,
+		Duration:   dur,
+	})
+}
+
+func (s *Service) block(entry blocklist.Entry) error {
+	if err := s.blocklist.Block(entry); err != nil {
+		return err
+	}
+
+	var (
+		peerID peer.ID
+		found  bool
+		err    error
+	)
+	if entry.PeerID != "" {
+		peerID, err = peer.Decode(entry.PeerID)
+		found = err == nil
+	} else {
+		peerID, found = s.peers.getPeerID(entry.EthAddress)
+	}
+	if found {
+		_ = s.host.Network().ClosePeer(peerID)
+	}
+
+	s.logger.Info("peer blocked", "peer", entry.Key(), "duration", entry.Duration, "reason", entry.Reason)
+	return nil
+}
 
-	info, ok := s.blockMap[peer]
-	if !ok {
-		return false
+// Unblock removes the peer identified by an ethereum address or a peer ID from
+// the blocklist.
+func (s *Service) Unblock(id string) error {
+	entry, err := parsePeer(id)
+	if err != nil {
+		return err
 	}
-	if time.Now().After(info.start.Add(info.duration)) && info.duration != 0 {
-		delete(s.blockMap, peer)
-		return false
+	if err := s.blocklist.Unblock(entry.Key()); err != nil {
+		return err
 	}
-	return true
+
+	s.logger.Info("peer unblocked", "peer", entry.Key())
+	return nil
+}
+
+// BlocklistHistory returns the changes of the blocklist, oldest first.
+func (s *Service) BlocklistHistory() []blocklist.Event {
+	return s.blocklist.History()
 }
 
 func (s *Service) BlockedPeers() []p2p.BlockedPeerInfo {
-	s.blockMu.Lock()
-	defer s.blockMu.Unlock()
+	now := time.Now()
 
 	var res []p2p.BlockedPeerInfo
-	for id, info := range s.blockMap {
-		if time.Now().Before(info.start.Add(info.duration)) || info.duration == 0 {
-			ethAddr, err := GetEthAddressFromPeerID(id)
-			if err != nil {
-				continue
-			}
-			var durString string
-			if info.duration == 0 {
-				durString = "Forever"
-			} else {
-				durString = time.Until(info.start.Add(info.duration)).String()
-			}
-			res = append(res, p2p.BlockedPeerInfo{
-				Peer:     ethAddr,
-				Reason:   info.reason,
-				Duration: durString,
-			})
-
+	for _, e := range s.blocklist.Entries() {
+		var durString string
+		if e.Duration == 0 {
+			durString = "Forever"
+		} else {
+			durString = e.Remaining(now).String()
 		}
+		res = append(res, p2p.BlockedPeerInfo{
+			Peer:     e.EthAddress,
+			PeerID:   e.PeerID,
+			Reason:   e.Reason,
+			Duration: durString,
+		})
 	}
 	return res
 }
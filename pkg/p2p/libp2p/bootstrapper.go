@@ -2,7 +2,10 @@ package libp2p
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -10,89 +13,290 @@ import (
 	madns "github.com/multiformats/go-multiaddr-dns"
 )
 
-func getPeerIDFromMultiaddr(maddr multiaddr.Multiaddr) (peer.ID, error) {
-	// Split the multiaddress into its components
-	components := maddr.Protocols()
+const (
+	// bootnodeInitialBackoff is the delay after the first failed dial of a
+	// bootnode. It doubles with every failure up to bootnodeMaxBackoff.
+	bootnodeInitialBackoff = 5 * time.Second
+	bootnodeMaxBackoff     = 10 * time.Minute
+	// bootnodeCheckInterval is the interval in which the connections to the
+	// connected bootnodes are checked.
+	bootnodeCheckInterval = time.Minute
+	bootnodeDialTimeout   = 30 * time.Second
+)
 
-	// Iterate over the components to find the p2p part which contains the peer ID
-	for _, value := range components {
-		if value.Code == multiaddr.P_P2P {
-			// Extract the peer ID part of the multiaddress
-			peerIDStr, err := maddr.ValueForProtocol(value.Code)
-			if err != nil {
-				return "", err
-			}
-			// Convert the extracted peer ID string into a peer.ID
-			pid, err := peer.Decode(peerIDStr)
-			if err != nil {
-				return "", err
-			}
-			return pid, nil
+var errNoResolvedAddrs = errors.New("no addresses found")
+
+// BootnodeStatus describes the connectivity to a bootnode.
+type BootnodeStatus struct {
+	Addr string `json:"addr"`
+	// PeerIDs are the peers the address resolved to on the last attempt.
+	PeerIDs   []string `json:"peer_ids,omitempty"`
+	Connected bool     `json:"connected"`
+	// Failures is the number of consecutive failed attempts.
+	Failures      int       `json:"failures"`
+	LastError     string    `json:"last_error,omitempty"`
+	LastAttempt   time.Time `json:"last_attempt"`
+	LastConnected time.Time `json:"last_connected"`
+	NextAttempt   time.Time `json:"next_attempt"`
+}
+
+// bootstrapper keeps the configured bootnodes and their status.
+type bootstrapper struct {
+	mu    sync.Mutex
+	nodes []*BootnodeStatus
+	// wake is signaled when the bootnodes change.
+	wake chan struct{}
+}
+
+func newBootstrapper(addrs []string) (*bootstrapper, error) {
+	b := &bootstrapper{wake: make(chan struct{}, 1)}
+	if err := b.set(addrs); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// set replaces the bootnodes. Bootnodes which were already configured keep
+// their status.
+func (b *bootstrapper) set(addrs []string) error {
+	for _, addr := range addrs {
+		if _, err := multiaddr.NewMultiaddr(addr); err != nil {
+			return fmt.Errorf("invalid bootnode %q: %w", addr, err)
+		}
+	}
+
+	b.mu.Lock()
+	prev := make(map[string]*BootnodeStatus, len(b.nodes))
+	for _, n := range b.nodes {
+		prev[n.Addr] = n
+	}
+	nodes := make([]*BootnodeStatus, 0, len(addrs))
+	for _, addr := range addrs {
+		n, found := prev[addr]
+		if !found {
+			n = &BootnodeStatus{Addr: addr}
+		}
+		nodes = append(nodes, n)
+	}
+	b.nodes = nodes
+	b.mu.Unlock()
+
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// due returns the addresses of the bootnodes which have to be dialed and
+// the time of the next attempt of the others.
+func (b *bootstrapper) due(now time.Time) ([]string, time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var (
+		addrs []string
+		next  = now.Add(bootnodeCheckInterval)
+	)
+	for _, n := range b.nodes {
+		if !n.NextAttempt.After(now) {
+			addrs = append(addrs, n.Addr)
+			continue
+		}
+		if n.NextAttempt.Before(next) {
+			next = n.NextAttempt
+		}
+	}
+	return addrs, next
+}
+
+// update records the result of an attempt. Bootnodes which were removed in
+// the meantime are ignored.
+func (b *bootstrapper) update(addr string, peerIDs []string, err error, now time.Time) time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, n := range b.nodes {
+		if n.Addr != addr {
+			continue
 		}
+		n.PeerIDs = peerIDs
+		n.LastAttempt = now
+		if err != nil {
+			n.Connected = false
+			n.Failures++
+			n.LastError = err.Error()
+			n.NextAttempt = now.Add(jitter(bootnodeBackoff(n.Failures)))
+		} else {
+			n.Connected = true
+			n.Failures = 0
+			n.LastError = ""
+			n.LastConnected = now
+			n.NextAttempt = now.Add(bootnodeCheckInterval)
+		}
+		return n.NextAttempt
+	}
+	return now.Add(bootnodeCheckInterval)
+}
+
+func (b *bootstrapper) status() []BootnodeStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := make([]BootnodeStatus, 0, len(b.nodes))
+	for _, n := range b.nodes {
+		res = append(res, *n)
+	}
+	return res
+}
+
+func bootnodeBackoff(failures int) time.Duration {
+	d := bootnodeInitialBackoff
+	for i := 1; i < failures && d < bootnodeMaxBackoff; i++ {
+		d *= 2
 	}
+	return min(d, bootnodeMaxBackoff)
+}
 
-	return "", fmt.Errorf("no peer ID found in multiaddress")
+// jitter returns a random duration between half and all of d so that nodes
+// which lost a bootnode at the same time don't redial it in lockstep.
+func jitter(d time.Duration) time.Duration {
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
 }
 
-func (p *Service) startBootstrapper(addrs []string) {
+// SetBootnodes replaces the bootnodes which the node keeps connected to.
+// New bootnodes are dialed right away.
+func (s *Service) SetBootnodes(addrs []string) error {
+	if err := s.bootstrapper.set(addrs); err != nil {
+		return err
+	}
+	s.logger.Info("bootnodes updated", "bootnodes", addrs)
+	return nil
+}
+
+// Bootnodes returns the connectivity status of the bootnodes.
+func (s *Service) Bootnodes() []BootnodeStatus {
+	return s.bootstrapper.status()
+}
+
+// startBootstrapper dials the bootnodes until the service is closed. Failed
+// bootnodes are retried with exponential backoff.
+func (s *Service) startBootstrapper() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
 	for {
-		for _, addr := range addrs {
-			mAddr, err := multiaddr.NewMultiaddr(addr)
-			if err != nil {
-				p.logger.Error("failed to parse bootstrap address", "addr", addr, "err", err)
-				continue
-			}
+		select {
+		case <-s.baseCtx.Done():
+			return
+		case <-s.bootstrapper.wake:
+		case <-timer.C:
+		}
 
-			var addrInfo *peer.AddrInfo
-
-			proto, _ := multiaddr.SplitFirst(mAddr)
-			if proto.Protocol().Code == multiaddr.P_DNSADDR {
-				resolvedAddrs, err := madns.DefaultResolver.Resolve(context.Background(), mAddr)
-				if err != nil {
-					p.logger.Error("failed to resolve bootstrap address", "addr", addr, "err", err)
-					continue
-				}
-				if len(resolvedAddrs) == 0 {
-					p.logger.Error("failed to resolve bootstrap address", "addr", addr, "err", "no addresses found")
-					continue
-				}
-				pID, err := getPeerIDFromMultiaddr(resolvedAddrs[0])
-				if err != nil {
-					p.logger.Error("failed to parse peer ID", "addr", addr, "err", err)
-					continue
-				}
-				p.logger.Debug("resolved bootstrap address", "addr", addr, "addrs", resolvedAddrs, "peerID", pID.String())
-				addrInfo = &peer.AddrInfo{
-					ID:    pID,
-					Addrs: resolvedAddrs,
-				}
-			} else {
-				addrInfo, err = peer.AddrInfoFromString(addr)
-				if err != nil {
-					p.logger.Error("failed to parse bootstrap address", "addr", addr, "err", err)
-					continue
-				}
-			}
+		next := s.dialBootnodes()
 
-			if _, connected := p.peers.isConnected(addrInfo.ID); connected {
-				p.logger.Debug("already connected to bootstrap peer", "peer", addrInfo.ID)
-				continue
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
 			}
+		}
+		timer.Reset(time.Until(next))
+	}
+}
+
+// dialBootnodes dials the bootnodes which are due and returns the time of the
+// next attempt.
+func (s *Service) dialBootnodes() time.Time {
+	addrs, next := s.bootstrapper.due(time.Now())
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, addr := range addrs {
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
 
-			addrInfoBytes, err := addrInfo.MarshalJSON()
+			peerIDs, err := s.dialBootnode(addr)
 			if err != nil {
-				p.logger.Error("failed to marshal bootstrap peer", "addr", addr, "err", err)
-				continue
+				s.logger.Error("failed to connect to bootnode", "addr", addr, "err", err)
 			}
+			t := s.bootstrapper.update(addr, peerIDs, err, time.Now())
 
-			peer, err := p.Connect(context.Background(), addrInfoBytes)
-			if err != nil {
-				p.logger.Error("failed to connect to bootstrap peer", "addr", addr, "err", err)
-				continue
+			mu.Lock()
+			if t.Before(next) {
+				next = t
 			}
+			mu.Unlock()
+		}(addr)
+	}
+	wg.Wait()
+	return next
+}
+
+// dialBootnode connects to all the peers the bootnode address resolves to.
+// It succeeds if at least one of them is connected.
+func (s *Service) dialBootnode(addr string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(s.baseCtx, bootnodeDialTimeout)
+	defer cancel()
+
+	infos, err := resolveBootnode(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		peerIDs   = make([]string, 0, len(infos))
+		errs      []error
+		connected bool
+	)
+	for _, info := range infos {
+		peerIDs = append(peerIDs, info.ID.String())
+
+		if _, found := s.peers.isConnected(info.ID); found {
+			connected = true
+			continue
+		}
 
-			p.logger.Info("connected to bootstrap peer", "peer", peer)
+		infoBytes, err := info.MarshalJSON()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		p, err := s.Connect(ctx, infoBytes)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("peer %s: %w", info.ID, err))
+			continue
+		}
+		connected = true
+		s.logger.Info("connected to bootnode", "addr", addr, "peer", p)
+	}
+	if !connected {
+		return peerIDs, errors.Join(errs...)
+	}
+	return peerIDs, nil
+}
+
+// resolveBootnode resolves dnsaddr bootnodes to all their addresses and
+// groups the addresses by peer.
+func resolveBootnode(ctx context.Context, addr string) ([]peer.AddrInfo, error) {
+	maddr, err := multiaddr.NewMultiaddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := []multiaddr.Multiaddr{maddr}
+	if proto, _ := multiaddr.SplitFirst(maddr); proto.Protocol().Code == multiaddr.P_DNSADDR {
+		addrs, err = madns.DefaultResolver.Resolve(ctx, maddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve: %w", err)
+		}
+		if len(addrs) == 0 {
+			return nil, errNoResolvedAddrs
 		}
-		time.Sleep(1 * time.Minute)
 	}
+	return peer.AddrInfosFromP2pAddrs(addrs...)
 }
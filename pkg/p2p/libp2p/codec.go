@@ -0,0 +1,117 @@
+package libp2p
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+)
+
+// codec compresses the data of the messages on a stream.
+type codec interface {
+	encode(src []byte) []byte
+	// decode fails with p2p.ErrMessageTooLarge if the decoded message is
+	// larger than maxSize, without decoding it.
+	decode(src []byte, maxSize int) ([]byte, error)
+	// maxEncodedLen is the maximum size of an encoded message of n bytes.
+	maxEncodedLen(n int) int
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecodeAllCapLimit(true))
+)
+
+var codecs = map[string]codec{
+	p2p.EncodingIdentity: identityCodec{},
+	p2p.EncodingSnappy:   snappyCodec{},
+	p2p.EncodingZstd:     zstdCodec{},
+}
+
+// parseEncodings returns the encodings offered to peers in order of
+// preference. Uncompressed messages are always accepted.
+func parseEncodings(compression []string) ([]string, error) {
+	res := make([]string, 0, len(compression)+1)
+	for _, e := range compression {
+		if _, found := codecs[e]; !found {
+			return nil, fmt.Errorf("unsupported compression %q", e)
+		}
+		if !slices.Contains(res, e) && e != p2p.EncodingIdentity {
+			res = append(res, e)
+		}
+	}
+	return append(res, p2p.EncodingIdentity), nil
+}
+
+type identityCodec struct{}
+
+func (identityCodec) encode(src []byte) []byte { return src }
+
+func (identityCodec) decode(src []byte, maxSize int) ([]byte, error) {
+	if len(src) > maxSize {
+		return nil, p2p.ErrMessageTooLarge
+	}
+	return src, nil
+}
+
+func (identityCodec) maxEncodedLen(n int) int { return n }
+
+type snappyCodec struct{}
+
+func (snappyCodec) encode(src []byte) []byte {
+	return snappy.Encode(nil, src)
+}
+
+func (snappyCodec) decode(src []byte, maxSize int) ([]byte, error) {
+	n, err := snappy.DecodedLen(src)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxSize {
+		return nil, p2p.ErrMessageTooLarge
+	}
+	return snappy.Decode(nil, src)
+}
+
+func (snappyCodec) maxEncodedLen(n int) int {
+	return snappy.MaxEncodedLen(n)
+}
+
+type zstdCodec struct{}
+
+func (zstdCodec) encode(src []byte) []byte {
+	return zstdEncoder.EncodeAll(src, nil)
+}
+
+// decode only accepts frames with the content size, which the encoder always
+// writes. The output is limited to the content size of the first frame so
+// that further frames can't exceed the limit.
+func (zstdCodec) decode(src []byte, maxSize int) ([]byte, error) {
+	// Empty messages are encoded without a frame.
+	if len(src) == 0 {
+		return nil, nil
+	}
+	var hdr zstd.Header
+	if err := hdr.Decode(src); err != nil {
+		return nil, err
+	}
+	if !hdr.HasFCS {
+		return nil, errors.New("zstd frame without content size")
+	}
+	if hdr.FrameContentSize > uint64(maxSize) {
+		return nil, p2p.ErrMessageTooLarge
+	}
+	return zstdDecoder.DecodeAll(src, make([]byte, 0, hdr.FrameContentSize))
+}
+
+// maxEncodedLen is the compress bound of the zstd reference implementation.
+func (zstdCodec) maxEncodedLen(n int) int {
+	bound := n + n>>8
+	if n < 128<<10 {
+		bound += (128<<10 - n) >> 11
+	}
+	return bound
+}
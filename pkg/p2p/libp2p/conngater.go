@@ -19,14 +19,16 @@ type blocker interface {
 
 type gater struct {
 	limiter *limiter
+	filter  *addrFilter
 	blocker blocker
 	logger  *slog.Logger
 }
 
 // NewGater returns a new libp2p connection gater.
-func newGater(logger *slog.Logger) *gater {
+func newGater(filter *addrFilter, logger *slog.Logger) *gater {
 	return &gater{
 		limiter: newLimiter(),
+		filter:  filter,
 		logger:  logger,
 	}
 }
@@ -43,12 +45,22 @@ func (g *gater) InterceptPeerDial(p peer.ID) bool {
 	return true
 }
 
-// No filters atm for dialing multiaddrs.
-func (*gater) InterceptAddrDial(_ peer.ID, _ multiaddr.Multiaddr) bool {
+func (g *gater) InterceptAddrDial(p peer.ID, addr multiaddr.Multiaddr) bool {
+	if !g.filter.allowDial(addr) {
+		g.logger.Debug("blocked dial: address is filtered", "peerID", p, "addr", addr)
+		return false
+	}
 	return true
 }
 
 func (g *gater) InterceptAccept(n network.ConnMultiaddrs) bool {
+	if !g.filter.allowAccept(n.RemoteMultiaddr()) {
+		g.logger.Warn(
+			"blocked accept: address is filtered",
+			"remoteAddr", n.RemoteMultiaddr(),
+		)
+		return false
+	}
 	if !g.limiter.Allow(n.RemoteMultiaddr()) {
 		g.logger.Warn(
 			"blocked accept: rate limit exceeded",
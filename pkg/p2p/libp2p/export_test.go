@@ -1,7 +1,9 @@
 package libp2p
 
 import (
+	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
 )
 
@@ -10,6 +12,10 @@ var (
 	NewMetadataStream = newMetadataStream
 )
 
+func NewEncodedStream(stream networkStream, encoding string, maxSize int) p2p.Stream {
+	return newProtocolStream(stream, nil, nil, codecs[encoding], maxSize)
+}
+
 func (s *Service) Addrs() ([]byte, error) {
 	info := s.host.Peerstore().PeerInfo(s.host.ID())
 	return info.MarshalJSON()
@@ -33,3 +39,23 @@ func (s *Service) AddrString() string {
 func (s *Service) PeerCount() int {
 	return len(s.host.Network().Peers())
 }
+
+func (s *Service) HostAddrs() []multiaddr.Multiaddr {
+	return s.host.Addrs()
+}
+
+func (s *Service) PeerAddrs(id peer.ID) []multiaddr.Multiaddr {
+	return s.host.Peerstore().Addrs(id)
+}
+
+func (s *Service) ClosePeer(id peer.ID) error {
+	return s.host.Network().ClosePeer(id)
+}
+
+func (s *Service) RefreshRecord(id peer.ID) error {
+	return s.refreshRecord(id)
+}
+
+func (s *Service) Host() host.Host {
+	return s.host
+}
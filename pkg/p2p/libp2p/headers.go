@@ -0,0 +1,110 @@
+package libp2p
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
+)
+
+// requestHeader returns the header of an outbound stream. The standard
+// headers are derived from ctx, headers set by the caller take precedence.
+// The trace context is added so that the handler of the peer continues the
+// trace.
+func (s *Service) requestHeader(ctx context.Context, hdr p2p.Header) p2p.Header {
+	res := make(p2p.Header, len(hdr)+8)
+
+	id := p2p.RequestIDFromContext(ctx)
+	if id == "" {
+		id = newRequestID()
+	}
+	res.SetRequestID(id)
+	res.SetClientVersion(s.version)
+	if deadline, ok := ctx.Deadline(); ok {
+		res.SetDeadline(deadline)
+	}
+	res.SetAcceptEncodings(s.encodings...)
+	if key := p2p.IdempotencyKeyFromContext(ctx); key != "" {
+		res.SetIdempotencyKey(key)
+	}
+	tracing.Inject(ctx, res)
+
+	maps.Copy(res, hdr)
+	return res
+}
+
+// responseHeader returns the header the handler answers with. It echoes the
+// request ID so that the peer can match the response and announces the
+// encoding of the messages.
+func responseHeader(req, resp p2p.Header, encoding string) p2p.Header {
+	if resp == nil {
+		resp = p2p.Header{}
+	}
+	if id := req.RequestID(); id != "" {
+		resp.SetRequestID(id)
+	}
+	resp.SetContentEncoding(encoding)
+	return resp
+}
+
+// negotiateEncoding returns the first encoding accepted by the peer which
+// the node offers as well. Messages to peers which don't announce encodings
+// are not compressed.
+func (s *Service) negotiateEncoding(req p2p.Header) string {
+	for _, e := range req.AcceptEncodings() {
+		if slices.Contains(s.encodings, e) {
+			return e
+		}
+	}
+	return p2p.EncodingIdentity
+}
+
+// maxMessageSize returns the message size limit of the protocol.
+func (s *Service) maxMessageSize(desc p2p.StreamDesc) int {
+	if desc.MaxMessageSize > 0 {
+		return desc.MaxMessageSize
+	}
+	return s.maxMsgSize
+}
+
+// meteredStream counts the bytes of the stream in the metrics of the
+// protocol.
+func (s *Service) meteredStream(stream networkStream, desc p2p.StreamDesc) *meteredStream {
+	return &meteredStream{
+		networkStream: stream,
+		read:          s.metrics.StreamReadBytesCount.WithLabelValues(desc.Name),
+		written:       s.metrics.StreamWrittenBytesCount.WithLabelValues(desc.Name),
+	}
+}
+
+// maxHandlerTimeout bounds the deadline a peer may set for a handler.
+const maxHandlerTimeout = time.Minute
+
+// handlerContext returns a copy of ctx with the request metadata and the
+// deadline of the peer, which is at most maxHandlerTimeout away. Streams the
+// handler opens with it carry the same request ID and the remaining time.
+func handlerContext(ctx context.Context, hdr p2p.Header) (context.Context, context.CancelFunc) {
+	if id := hdr.RequestID(); id != "" {
+		ctx = p2p.WithRequestID(ctx, id)
+	}
+	ctx = p2p.ContextWithHeader(ctx, hdr)
+	if deadline, ok := hdr.Deadline(); ok {
+		latest := time.Now().Add(maxHandlerTimeout)
+		if deadline.After(latest) {
+			deadline = latest
+		}
+		return context.WithDeadline(ctx, deadline)
+	}
+	return context.WithCancel(ctx)
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,93 @@
+package libp2p
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/primevprotocol/mev-commit/pkg/util"
+)
+
+// LoadOrCreateIdentityKey loads the libp2p identity key from path. If the file
+// does not exist, a new Ed25519 key is generated and stored there. The identity
+// key only determines the peer ID of the node and is independent of the
+// ethereum key used for signing.
+func LoadOrCreateIdentityKey(path string) (libp2pcrypto.PrivKey, error) {
+	keyFile, err := util.ResolveFilePath(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity key file path: %w", err)
+	}
+
+	data, err := os.ReadFile(keyFile)
+	switch {
+	case err == nil:
+		key, err := libp2pcrypto.UnmarshalPrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal identity key from file '%s': %w", keyFile, err)
+		}
+		return key, nil
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("failed to read identity key from file '%s': %w", keyFile, err)
+	}
+
+	key, _, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeIdentityKey(keyFile, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// WriteEthereumIdentityKey stores the secp256k1 ethereum key as the libp2p
+// identity key at path. Nodes which derived their peer ID from the ethereum key
+// keep it this way. An existing identity key is never overwritten.
+func WriteEthereumIdentityKey(path string, ethKey *ecdsa.PrivateKey) (libp2pcrypto.PrivKey, error) {
+	keyFile, err := util.ResolveFilePath(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity key file path: %w", err)
+	}
+
+	if _, err := os.Stat(keyFile); err == nil {
+		return nil, fmt.Errorf("identity key file '%s' already exists", keyFile)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to check identity key file '%s': %w", keyFile, err)
+	}
+
+	key, err := libp2pcrypto.UnmarshalSecp256k1PrivateKey(crypto.FromECDSA(ethKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert ethereum key: %w", err)
+	}
+
+	if err := writeIdentityKey(keyFile, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func writeIdentityKey(keyFile string, key libp2pcrypto.PrivKey) error {
+	data, err := libp2pcrypto.MarshalPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(keyFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to write identity key to file '%s': %w", keyFile, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package libp2p_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p"
+)
+
+func TestIdentityKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ethereum key", func(t *testing.T) {
+		ethKey, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		path := filepath.Join(t.TempDir(), "p2p_key")
+		key, err := libp2p.WriteEthereumIdentityKey(path, ethKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		id, err := peer.IDFromPrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Peer IDs of secp256k1 keys have the same prefix as the peer IDs
+		// derived from the ethereum key before.
+		if !strings.HasPrefix(id.String(), "16Uiu2") {
+			t.Fatalf("unexpected peer ID %s", id)
+		}
+
+		loaded, err := libp2p.LoadOrCreateIdentityKey(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !loaded.Equals(key) {
+			t.Fatal("expected the written identity key to be loaded")
+		}
+
+		if _, err := libp2p.WriteEthereumIdentityKey(path, ethKey); err == nil {
+			t.Fatal("expected error when overwriting the identity key")
+		}
+	})
+
+	t.Run("create", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "p2p_key")
+		key, err := libp2p.LoadOrCreateIdentityKey(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		loaded, err := libp2p.LoadOrCreateIdentityKey(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !loaded.Equals(key) {
+			t.Fatal("expected the created identity key to be loaded")
+		}
+	})
+}
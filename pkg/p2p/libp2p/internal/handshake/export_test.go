@@ -0,0 +1,11 @@
+package handshake
+
+import "time"
+
+func (h *Service) SetNow(now func() time.Time) {
+	h.now = now
+}
+
+func (h *Service) SetUnderlay(underlay func() ([]byte, error)) {
+	h.underlay = underlay
+}
@@ -3,9 +3,14 @@ package handshake
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/libp2p/go-libp2p/core"
@@ -13,134 +18,327 @@ import (
 	handshakepb "github.com/primevprotocol/mev-commit/gen/go/handshake/v1"
 	"github.com/primevprotocol/mev-commit/pkg/keysigner"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/peerrecord"
 	"github.com/primevprotocol/mev-commit/pkg/signer"
 )
 
 const (
 	ProtocolName    = "handshake"
-	ProtocolVersion = "2.0.0"
+	ProtocolVersion = "4.0.0"
 	StreamName      = "handshake"
+
+	// Timeout is the deadline for the whole handshake.
+	Timeout = 10 * time.Second
+	// MaxClockSkew is the maximum difference between the timestamp of a
+	// request and the local time.
+	MaxClockSkew = 30 * time.Second
+
+	nonceSize = 32
+	// signingDomain separates handshake signatures from any other data
+	// signed by the ethereum key.
+	signingDomain = "mev-commit/handshake/4"
 )
 
 var (
 	ErrSignatureVerificationFailed = errors.New("signature verification failed")
-	ErrObservedAddressMismatch     = errors.New("observed address mismatch")
+	ErrInvalidPeerIDBinding        = errors.New("invalid peer ID binding")
 	ErrInsufficientStake           = errors.New("insufficient stake")
+	ErrInvalidPeerType             = errors.New("invalid peer type")
+	ErrInvalidNonce                = errors.New("invalid nonce")
+	ErrInvalidTimestamp            = errors.New("timestamp out of range")
+	ErrChainIDMismatch             = errors.New("chain ID mismatch")
+	ErrNetworkIDMismatch           = errors.New("network ID mismatch")
+	ErrIncompatibleProtocol        = errors.New("incompatible protocol")
+	ErrInvalidPeerRecord           = errors.New("invalid peer record")
 )
 
 type ProviderRegistry interface {
 	CheckProviderRegistered(context.Context, common.Address) bool
 }
 
+type Options struct {
+	KeySigner keysigner.KeySigner
+	PeerType  p2p.PeerType
+	Passcode  string
+	Signer    signer.Signer
+	Register  ProviderRegistry
+	// SelfID is the libp2p peer ID of the node which is bound to the ethereum
+	// address of the key signer in the handshake request.
+	SelfID core.PeerID
+	// Peers only complete the handshake if they are on the same chain and
+	// network.
+	ChainID   *big.Int
+	NetworkID uint64
+	// Version is the version of the node software.
+	Version string
+	// Protocols returns the stream protocols currently handled by the node.
+	Protocols func() []p2p.ProtocolInfo
+	// Features are optional behaviours supported by the node.
+	Features []string
+	// Underlay returns the address info of the node which is signed in its
+	// peer record.
+	Underlay func() ([]byte, error)
+}
+
 // Handshake is the handshake protocol
 type Service struct {
-	ks            keysigner.KeySigner
-	peerType      p2p.PeerType
-	passcode      string
-	signer        signer.Signer
-	register      ProviderRegistry
-	handshakeReq  *handshakepb.HandshakeReq
-	getEthAddress func(core.PeerID) (common.Address, error)
+	ks        keysigner.KeySigner
+	peerType  p2p.PeerType
+	passcode  string
+	signer    signer.Signer
+	register  ProviderRegistry
+	selfID    core.PeerID
+	chainID   *big.Int
+	networkID uint64
+	version   string
+	protocols func() []p2p.ProtocolInfo
+	features  []string
+	underlay  func() ([]byte, error)
+	now       func() time.Time
 }
 
-func New(
-	ks keysigner.KeySigner,
-	peerType p2p.PeerType,
-	passcode string,
-	signer signer.Signer,
-	register ProviderRegistry,
-	getEthAddress func(core.PeerID) (common.Address, error),
-) (*Service, error) {
-	s := &Service{
-		ks:            ks,
-		peerType:      peerType,
-		passcode:      passcode,
-		signer:        signer,
-		register:      register,
-		getEthAddress: getEthAddress,
-	}
-
-	err := s.setHandshakeReq()
-	if err != nil {
-		return nil, err
+func New(opts Options) *Service {
+	protocols := opts.Protocols
+	if protocols == nil {
+		protocols = func() []p2p.ProtocolInfo { return nil }
 	}
 
-	return s, nil
+	return &Service{
+		ks:        opts.KeySigner,
+		peerType:  opts.PeerType,
+		passcode:  opts.Passcode,
+		signer:    opts.Signer,
+		register:  opts.Register,
+		selfID:    opts.SelfID,
+		chainID:   opts.ChainID,
+		networkID: opts.NetworkID,
+		version:   opts.Version,
+		protocols: protocols,
+		features:  opts.Features,
+		underlay:  opts.Underlay,
+		now:       time.Now,
+	}
 }
 
 func ProtocolID() protocol.ID {
 	return protocol.ID(fmt.Sprintf("/%s/%s", ProtocolName, ProtocolVersion))
 }
 
-type HandshakeReq struct {
-	PeerType string
-	Token    string
-	Sig      []byte
+func newNonce() ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return nonce, nil
 }
 
-type HandshakeResp struct {
-	ObservedAddress common.Address
-	PeerType        string
+// signingData returns the data signed in the handshake request. The peer ID
+// binds the request to the libp2p identity of the sender and the challenge
+// of the remote peer binds it to the connection.
+func signingData(
+	req *handshakepb.HandshakeReq,
+	peerID core.PeerID,
+	challenge []byte,
+) []byte {
+	var buf bytes.Buffer
+	// Length prefixes keep the encoding unambiguous.
+	writeField := func(field []byte) {
+		_ = binary.Write(&buf, binary.BigEndian, uint32(len(field)))
+		buf.Write(field)
+	}
+
+	writeField([]byte(signingDomain))
+	writeField([]byte(req.PeerType))
+	writeField([]byte(req.Token))
+	writeField(req.EthAddress)
+	writeField([]byte(peerID))
+	writeField(challenge)
+	writeField(req.Nonce)
+	writeField([]byte(req.Version))
+	_ = binary.Write(&buf, binary.BigEndian, req.Timestamp)
+	_ = binary.Write(&buf, binary.BigEndian, req.ChainId)
+	_ = binary.Write(&buf, binary.BigEndian, req.NetworkId)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(req.Protocols)))
+	for _, p := range req.Protocols {
+		writeField([]byte(p.Name))
+		writeField([]byte(p.Version))
+	}
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(req.Features)))
+	for _, f := range req.Features {
+		writeField([]byte(f))
+	}
+	writeField(req.Underlay)
+	writeField(req.RecordSig)
+	return buf.Bytes()
+}
+
+// newReq creates the signed request answering the challenge of the remote
+// peer. The nonce is the challenge for the remote peer.
+func (h *Service) newReq(challenge, nonce []byte) (*handshakepb.HandshakeReq, error) {
+	req := &handshakepb.HandshakeReq{
+		PeerType:   h.peerType.String(),
+		Token:      h.passcode,
+		EthAddress: h.ks.GetAddress().Bytes(),
+		Nonce:      nonce,
+		Timestamp:  h.now().Unix(),
+		ChainId:    h.chainID.Uint64(),
+		NetworkId:  h.networkID,
+		Version:    h.version,
+		Features:   h.features,
+	}
+	for _, p := range h.protocols() {
+		req.Protocols = append(req.Protocols, &handshakepb.Protocol{
+			Name:    p.Name,
+			Version: p.Version,
+		})
+	}
+
+	if h.underlay != nil {
+		underlay, err := h.underlay()
+		if err != nil {
+			return nil, err
+		}
+		record, err := peerrecord.Sign(h.ks, underlay, req.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		req.Underlay = record.Underlay
+		req.RecordSig = record.Signature
+	}
+
+	hash := crypto.Keccak256Hash(signingData(req, h.selfID, challenge))
+	sig, err := h.ks.SignHash(hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	req.Sig = sig
+
+	return req, nil
 }
 
 func (h *Service) verifyReq(
+	ctx context.Context,
 	req *handshakepb.HandshakeReq,
 	peerID core.PeerID,
-) (common.Address, error) {
-	unsignedData := []byte(req.PeerType + req.Token)
+	challenge []byte,
+) (*p2p.Peer, error) {
+	if len(req.Nonce) != nonceSize {
+		return nil, ErrInvalidNonce
+	}
+
+	if req.ChainId != h.chainID.Uint64() {
+		return nil, ErrChainIDMismatch
+	}
+
+	if req.NetworkId != h.networkID {
+		return nil, ErrNetworkIDMismatch
+	}
 
-	verified, ethAddress, err := h.signer.Verify(req.Sig, unsignedData)
+	skew := h.now().Sub(time.Unix(req.Timestamp, 0))
+	if skew > MaxClockSkew || skew < -MaxClockSkew {
+		return nil, ErrInvalidTimestamp
+	}
+
+	verified, ethAddress, err := h.signer.Verify(req.Sig, signingData(req, peerID, challenge))
 	if err != nil {
-		return common.Address{}, errors.Join(err, ErrSignatureVerificationFailed)
+		return nil, errors.Join(err, ErrSignatureVerificationFailed)
 	}
 
 	if !verified {
-		return common.Address{}, ErrSignatureVerificationFailed
+		return nil, ErrSignatureVerificationFailed
 	}
 
-	observedEthAddress, err := h.getEthAddress(peerID)
-	if err != nil {
-		return common.Address{}, err
+	// The recovered address only matches the claimed one if the request was
+	// signed for the peer ID of this connection.
+	if !bytes.Equal(req.EthAddress, ethAddress.Bytes()) {
+		return nil, ErrInvalidPeerIDBinding
 	}
 
-	if !bytes.Equal(observedEthAddress.Bytes(), ethAddress.Bytes()) {
-		return common.Address{}, ErrObservedAddressMismatch
+	if err := h.checkProtocols(req.Protocols); err != nil {
+		return nil, err
+	}
+
+	if p2p.FromString(req.PeerType) == -1 {
+		return nil, ErrInvalidPeerType
 	}
 
+	// Observers and bidders are accepted without stake.
 	if req.PeerType == p2p.PeerTypeProvider.String() {
-		if !h.register.CheckProviderRegistered(context.Background(), ethAddress) {
-			return common.Address{}, ErrInsufficientStake
+		if !h.register.CheckProviderRegistered(ctx, ethAddress) {
+			return nil, ErrInsufficientStake
 		}
 	}
 
-	return ethAddress, nil
-}
+	p := &p2p.Peer{
+		EthAddress:   ethAddress,
+		Type:         p2p.FromString(req.PeerType),
+		Capabilities: capabilities(req),
+	}
 
-func (h *Service) createSignature() ([]byte, error) {
-	unsignedData := []byte(h.peerType.String() + h.passcode)
-	hash := crypto.Keccak256Hash(unsignedData)
-	sig, err := h.ks.SignHash(hash.Bytes())
-	if err != nil {
-		return nil, err
+	// Peers without an underlay are not announced to other peers.
+	if len(req.Underlay) > 0 {
+		record := p2p.PeerInfo{
+			EthAddress: ethAddress,
+			Underlay:   req.Underlay,
+			Timestamp:  req.Timestamp,
+			Signature:  req.RecordSig,
+		}
+		addrInfo, err := peerrecord.Verify(record, h.now())
+		if err != nil {
+			return nil, errors.Join(err, ErrInvalidPeerRecord)
+		}
+		if addrInfo.ID != peerID {
+			return nil, fmt.Errorf("%w: peer ID mismatch", ErrInvalidPeerRecord)
+		}
+		p.Record = &record
 	}
 
-	return sig, nil
+	return p, nil
 }
 
-func (h *Service) setHandshakeReq() error {
-	sig, err := h.createSignature()
-	if err != nil {
-		return err
+// checkProtocols rejects peers which handle a protocol of this node with a
+// different major version. Streams of such protocols would always be refused.
+func (h *Service) checkProtocols(remote []*handshakepb.Protocol) error {
+	for _, local := range h.protocols() {
+		for _, p := range remote {
+			if p.Name != local.Name {
+				continue
+			}
+			localVersion, err := semver.NewVersion(local.Version)
+			if err != nil {
+				return err
+			}
+			remoteVersion, err := semver.NewVersion(p.Version)
+			if err != nil {
+				return fmt.Errorf("%w: invalid version %q of %s", ErrIncompatibleProtocol, p.Version, p.Name)
+			}
+			if localVersion.Major() != remoteVersion.Major() {
+				return fmt.Errorf(
+					"%w: %s local version %s remote version %s",
+					ErrIncompatibleProtocol,
+					p.Name,
+					local.Version,
+					p.Version,
+				)
+			}
+		}
 	}
+	return nil
+}
 
-	req := &handshakepb.HandshakeReq{
-		PeerType: h.peerType.String(),
-		Token:    h.passcode,
-		Sig:      sig,
+func capabilities(req *handshakepb.HandshakeReq) *p2p.Capabilities {
+	caps := &p2p.Capabilities{
+		Version:  req.Version,
+		ChainID:  req.ChainId,
+		Features: req.Features,
 	}
-
-	h.handshakeReq = req
-	return nil
+	for _, p := range req.Protocols {
+		caps.Protocols = append(caps.Protocols, p2p.ProtocolInfo{
+			Name:    p.Name,
+			Version: p.Version,
+		})
+	}
+	return caps
 }
 
 func (h *Service) verifyResp(resp *handshakepb.HandshakeResp) error {
@@ -155,94 +353,126 @@ func (h *Service) verifyResp(resp *handshakepb.HandshakeResp) error {
 	return nil
 }
 
+// Handle runs the handshake for an inbound connection. The accept function is
+// called with the verified peer before the dialer is told that the handshake
+// succeeded, so the peer is known once the dialer starts using it.
 func (h *Service) Handle(
 	ctx context.Context,
 	stream p2p.Stream,
 	peerID core.PeerID,
+	accept func(*p2p.Peer) error,
 ) (*p2p.Peer, error) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	challenge := new(handshakepb.HandshakeChallenge)
+	if err := stream.ReadMsg(ctx, challenge); err != nil {
+		return nil, err
+	}
 
-	req := new(handshakepb.HandshakeReq)
-	err := stream.ReadMsg(ctx, req)
+	if len(challenge.Nonce) != nonceSize {
+		return nil, ErrInvalidNonce
+	}
+
+	nonce, err := newNonce()
 	if err != nil {
 		return nil, err
 	}
 
-	ethAddress, err := h.verifyReq(req, peerID)
+	req, err := h.newReq(challenge.Nonce, nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &handshakepb.HandshakeResp{
-		ObservedAddress: ethAddress.Bytes(),
-		PeerType:        req.PeerType,
+	if err := stream.WriteMsg(ctx, req); err != nil {
+		return nil, err
 	}
 
-	if err := stream.WriteMsg(ctx, resp); err != nil {
+	remoteReq := new(handshakepb.HandshakeReq)
+	if err := stream.ReadMsg(ctx, remoteReq); err != nil {
 		return nil, err
 	}
 
-	err = stream.WriteMsg(ctx, h.handshakeReq)
+	// The dialer repeats the nonce of its challenge.
+	if !bytes.Equal(remoteReq.Nonce, challenge.Nonce) {
+		return nil, ErrInvalidNonce
+	}
+
+	p, err := h.verifyReq(ctx, remoteReq, peerID, req.Nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	ack := new(handshakepb.HandshakeResp)
-	err = stream.ReadMsg(ctx, ack)
-	if err != nil {
+	if err := accept(p); err != nil {
 		return nil, err
 	}
 
-	if err := h.verifyResp(ack); err != nil {
+	resp := &handshakepb.HandshakeResp{
+		ObservedAddress: p.EthAddress.Bytes(),
+		PeerType:        remoteReq.PeerType,
+	}
+
+	if err := stream.WriteMsg(ctx, resp); err != nil {
 		return nil, err
 	}
 
-	return &p2p.Peer{
-		EthAddress: ethAddress,
-		Type:       p2p.FromString(req.PeerType),
-	}, nil
+	return p, nil
 }
 
+// Handshake runs the handshake for an outbound connection. The accept
+// function is called with the verified peer before the remote peer verifies
+// this node, so the peer is known once the remote peer starts using it.
 func (h *Service) Handshake(
 	ctx context.Context,
 	peerID core.PeerID,
 	stream p2p.Stream,
+	accept func(*p2p.Peer) error,
 ) (*p2p.Peer, error) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
 
-	if err := stream.WriteMsg(ctx, h.handshakeReq); err != nil {
+	nonce, err := newNonce()
+	if err != nil {
 		return nil, err
 	}
 
-	resp := new(handshakepb.HandshakeResp)
-	err := stream.ReadMsg(ctx, resp)
+	err = stream.WriteMsg(ctx, &handshakepb.HandshakeChallenge{Nonce: nonce})
 	if err != nil {
 		return nil, err
 	}
 
-	if err := h.verifyResp(resp); err != nil {
+	remoteReq := new(handshakepb.HandshakeReq)
+	if err := stream.ReadMsg(ctx, remoteReq); err != nil {
 		return nil, err
 	}
 
-	ack := new(handshakepb.HandshakeReq)
-	err = stream.ReadMsg(ctx, ack)
+	p, err := h.verifyReq(ctx, remoteReq, peerID, nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	ethAddress, err := h.verifyReq(ack, peerID)
+	// The nonce of the challenge is repeated in the request.
+	req, err := h.newReq(remoteReq.Nonce, nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	err = stream.WriteMsg(ctx, &handshakepb.HandshakeResp{
-		ObservedAddress: ethAddress.Bytes(),
-		PeerType:        ack.PeerType,
-	})
-	if err != nil {
+	if err := accept(p); err != nil {
+		return nil, err
+	}
+
+	if err := stream.WriteMsg(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp := new(handshakepb.HandshakeResp)
+	if err := stream.ReadMsg(ctx, resp); err != nil {
+		return nil, err
+	}
+
+	if err := h.verifyResp(resp); err != nil {
 		return nil, err
 	}
 
-	return &p2p.Peer{
-		EthAddress: ethAddress,
-		Type:       p2p.FromString(ack.PeerType),
-	}, nil
+	return p, nil
 }
@@ -2,86 +2,94 @@ package handshake_test
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/elliptic"
 	"crypto/rand"
+	"errors"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/libp2p/go-libp2p/core"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	handshakepb "github.com/primevprotocol/mev-commit/gen/go/handshake/v1"
 	mockkeysigner "github.com/primevprotocol/mev-commit/pkg/keysigner/mock"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
 	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p/internal/handshake"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/peerrecord"
 	p2ptest "github.com/primevprotocol/mev-commit/pkg/p2p/testing"
+	"github.com/primevprotocol/mev-commit/pkg/signer"
 )
 
-type testRegister struct{}
+type testRegister struct {
+	unregistered bool
+}
 
 func (t *testRegister) CheckProviderRegistered(
-	_ context.Context,
+	ctx context.Context,
 	_ common.Address,
 ) bool {
-	return true
+	// The lookup has to be bounded by the deadline of the handshake.
+	if _, ok := ctx.Deadline(); !ok {
+		return false
+	}
+	return !t.unregistered
 }
 
-type testSigner struct {
-	address common.Address
-}
+var chainID = big.NewInt(17864)
 
-func (t *testSigner) Sign(_ *ecdsa.PrivateKey, _ []byte) ([]byte, error) {
-	return []byte("signature"), nil
-}
+func newTestService(
+	t *testing.T,
+	self core.PeerID,
+	networkID uint64,
+	protocols ...p2p.ProtocolInfo,
+) (*handshake.Service, common.Address) {
+	t.Helper()
 
-func (t *testSigner) Verify(_ []byte, _ []byte) (bool, common.Address, error) {
-	return true, t.address, nil
+	return newTypedTestService(t, self, p2p.PeerTypeProvider, &testRegister{}, networkID, protocols...)
 }
 
-func TestHandshake(t *testing.T) {
-	t.Parallel()
+func newTypedTestService(
+	t *testing.T,
+	self core.PeerID,
+	peerType p2p.PeerType,
+	register handshake.ProviderRegistry,
+	networkID uint64,
+	protocols ...p2p.ProtocolInfo,
+) (*handshake.Service, common.Address) {
+	t.Helper()
 
-	t.Run("ok", func(t *testing.T) {
-		privKey1, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-		if err != nil {
-			t.Fatal(err)
-		}
-		address1 := common.HexToAddress("0x1")
-		ks1 := mockkeysigner.NewMockKeySigner(privKey1, address1)
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+	ks := mockkeysigner.NewMockKeySigner(privKey, address)
 
-		privKey2, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-		if err != nil {
-			t.Fatal(err)
-		}
+	return handshake.New(handshake.Options{
+		KeySigner: ks,
+		PeerType:  peerType,
+		Passcode:  "test",
+		Signer:    signer.New(),
+		Register:  register,
+		SelfID:    self,
+		ChainID:   chainID,
+		NetworkID: networkID,
+		Version:   "v1.0.0",
+		Protocols: func() []p2p.ProtocolInfo { return protocols },
+		Features:  []string{"test"},
+	}), address
+}
 
-		address2 := common.HexToAddress("0x2")
-		ks2 := mockkeysigner.NewMockKeySigner(privKey2, address2)
+func accept(*p2p.Peer) error { return nil }
 
-		hs1, err := handshake.New(
-			ks1,
-			p2p.PeerTypeProvider,
-			"test",
-			&testSigner{address: address2},
-			&testRegister{},
-			func(p core.PeerID) (common.Address, error) {
-				return address2, nil
-			},
-		)
-		if err != nil {
-			t.Fatal(err)
-		}
+func TestHandshake(t *testing.T) {
+	t.Parallel()
 
-		hs2, err := handshake.New(
-			ks2,
-			p2p.PeerTypeProvider,
-			"test",
-			&testSigner{address: address1},
-			&testRegister{},
-			func(p core.PeerID) (common.Address, error) {
-				return address1, nil
-			},
-		)
-		if err != nil {
-			t.Fatal(err)
-		}
+	t.Run("ok", func(t *testing.T) {
+		hs1, address1 := newTestService(t, "test1", 1, p2p.ProtocolInfo{Name: "test", Version: "1.0.0"})
+		hs2, address2 := newTestService(t, "test2", 1, p2p.ProtocolInfo{Name: "test", Version: "1.1.0"})
 
 		out, in := p2ptest.NewDuplexStream()
 
@@ -89,7 +97,11 @@ func TestHandshake(t *testing.T) {
 		go func() {
 			defer close(done)
 
-			p, err := hs1.Handle(context.Background(), in, core.PeerID("test2"))
+			var accepted *p2p.Peer
+			p, err := hs1.Handle(context.Background(), in, "test2", func(p *p2p.Peer) error {
+				accepted = p
+				return nil
+			})
 			if err != nil {
 				t.Error(err)
 				return
@@ -105,9 +117,12 @@ func TestHandshake(t *testing.T) {
 				t.Errorf("expected peer type %s, got %s", p2p.PeerTypeProvider, p.Type)
 				return
 			}
+			if accepted != p {
+				t.Error("expected peer to be accepted")
+			}
 		}()
 
-		p, err := hs2.Handshake(context.Background(), core.PeerID("test1"), out)
+		p, err := hs2.Handshake(context.Background(), "test1", out, accept)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -117,6 +132,236 @@ func TestHandshake(t *testing.T) {
 		if p.Type != p2p.PeerTypeProvider {
 			t.Fatalf("expected peer type %s, got %s", p2p.PeerTypeProvider, p.Type)
 		}
+		caps := p.Capabilities
+		if caps == nil || caps.Version != "v1.0.0" || caps.ChainID != chainID.Uint64() {
+			t.Fatalf("unexpected capabilities %+v", caps)
+		}
+		if !caps.SupportsProtocol("test", "1.0.0") || !caps.HasFeature("test") {
+			t.Fatalf("unexpected capabilities %+v", caps)
+		}
 		<-done
 	})
+
+	t.Run("incompatible protocol", func(t *testing.T) {
+		hs1, _ := newTestService(t, "test1", 1, p2p.ProtocolInfo{Name: "test", Version: "1.0.0"})
+		hs2, _ := newTestService(t, "test2", 1, p2p.ProtocolInfo{Name: "test", Version: "2.0.0"})
+
+		out, in := p2ptest.NewDuplexStream()
+
+		go func() {
+			_, _ = hs1.Handle(context.Background(), in, "test2", accept)
+			_ = in.Close()
+		}()
+
+		_, err := hs2.Handshake(context.Background(), "test1", out, accept)
+		if !errors.Is(err, handshake.ErrIncompatibleProtocol) {
+			t.Fatalf("expected error %v, got %v", handshake.ErrIncompatibleProtocol, err)
+		}
+	})
+
+	t.Run("invalid peer ID binding", func(t *testing.T) {
+		hs1, _ := newTestService(t, "test1", 1)
+		hs2, _ := newTestService(t, "test2", 1)
+
+		out, in := p2ptest.NewDuplexStream()
+
+		go func() {
+			_, _ = hs1.Handle(context.Background(), in, "test2", accept)
+			_ = in.Close()
+		}()
+
+		// The responder signed for its own peer ID, not for "other".
+		_, err := hs2.Handshake(context.Background(), "other", out, accept)
+		if !errors.Is(err, handshake.ErrInvalidPeerIDBinding) {
+			t.Fatalf("expected error %v, got %v", handshake.ErrInvalidPeerIDBinding, err)
+		}
+	})
+
+	t.Run("replayed request", func(t *testing.T) {
+		hs1, _ := newTestService(t, "test1", 1)
+		hs2, _ := newTestService(t, "test2", 1)
+
+		// Capture the request of the responder in a first handshake.
+		out, in := p2ptest.NewDuplexStream()
+		go func() {
+			_, _ = hs1.Handle(context.Background(), in, "test2", accept)
+			_ = in.Close()
+		}()
+
+		nonce := make([]byte, 32)
+		err := out.WriteMsg(context.Background(), &handshakepb.HandshakeChallenge{Nonce: nonce})
+		if err != nil {
+			t.Fatal(err)
+		}
+		captured := new(handshakepb.HandshakeReq)
+		if err := out.ReadMsg(context.Background(), captured); err != nil {
+			t.Fatal(err)
+		}
+		_ = out.Close()
+
+		// Replay it as the answer to the fresh challenge of another handshake.
+		replayOut, replayIn := p2ptest.NewDuplexStream()
+		go func() {
+			challenge := new(handshakepb.HandshakeChallenge)
+			if err := replayIn.ReadMsg(context.Background(), challenge); err != nil {
+				t.Error(err)
+				return
+			}
+			if err := replayIn.WriteMsg(context.Background(), captured); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		_, err = hs2.Handshake(context.Background(), "test1", replayOut, accept)
+		if !errors.Is(err, handshake.ErrInvalidPeerIDBinding) {
+			t.Fatalf("expected error %v, got %v", handshake.ErrInvalidPeerIDBinding, err)
+		}
+	})
+
+	t.Run("network ID mismatch", func(t *testing.T) {
+		hs1, _ := newTestService(t, "test1", 1)
+		hs2, _ := newTestService(t, "test2", 2)
+
+		out, in := p2ptest.NewDuplexStream()
+
+		go func() {
+			_, _ = hs1.Handle(context.Background(), in, "test2", accept)
+			_ = in.Close()
+		}()
+
+		_, err := hs2.Handshake(context.Background(), "test1", out, accept)
+		if !errors.Is(err, handshake.ErrNetworkIDMismatch) {
+			t.Fatalf("expected error %v, got %v", handshake.ErrNetworkIDMismatch, err)
+		}
+	})
+
+	t.Run("stale timestamp", func(t *testing.T) {
+		hs1, _ := newTestService(t, "test1", 1)
+		hs1.SetNow(func() time.Time {
+			return time.Now().Add(-2 * handshake.MaxClockSkew)
+		})
+		hs2, _ := newTestService(t, "test2", 1)
+
+		out, in := p2ptest.NewDuplexStream()
+
+		go func() {
+			_, _ = hs1.Handle(context.Background(), in, "test2", accept)
+			_ = in.Close()
+		}()
+
+		_, err := hs2.Handshake(context.Background(), "test1", out, accept)
+		if !errors.Is(err, handshake.ErrInvalidTimestamp) {
+			t.Fatalf("expected error %v, got %v", handshake.ErrInvalidTimestamp, err)
+		}
+	})
+
+	t.Run("observer without stake", func(t *testing.T) {
+		unregistered := &testRegister{unregistered: true}
+		hs1, _ := newTypedTestService(t, "test1", p2p.PeerTypeProvider, unregistered, 1)
+		hs2, address2 := newTypedTestService(t, "test2", p2p.PeerTypeObserver, &testRegister{}, 1)
+
+		out, in := p2ptest.NewDuplexStream()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			p, err := hs1.Handle(context.Background(), in, "test2", accept)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if p.EthAddress != address2 || p.Type != p2p.PeerTypeObserver {
+				t.Errorf("unexpected peer %v", p)
+			}
+		}()
+
+		if _, err := hs2.Handshake(context.Background(), "test1", out, accept); err != nil {
+			t.Fatal(err)
+		}
+		<-done
+	})
+
+	t.Run("provider without stake", func(t *testing.T) {
+		unregistered := &testRegister{unregistered: true}
+		hs1, _ := newTypedTestService(t, "test1", p2p.PeerTypeProvider, unregistered, 1)
+		hs2, _ := newTypedTestService(t, "test2", p2p.PeerTypeProvider, &testRegister{}, 1)
+
+		out, in := p2ptest.NewDuplexStream()
+
+		errC := make(chan error, 1)
+		go func() {
+			_, err := hs1.Handle(context.Background(), in, "test2", accept)
+			errC <- err
+			_ = in.Close()
+		}()
+
+		_, _ = hs2.Handshake(context.Background(), "test1", out, accept)
+		if err := <-errC; !errors.Is(err, handshake.ErrInsufficientStake) {
+			t.Fatalf("expected error %v, got %v", handshake.ErrInsufficientStake, err)
+		}
+	})
+
+	t.Run("peer record", func(t *testing.T) {
+		id1, id2 := newPeerID(t), newPeerID(t)
+		hs1, address1 := newTestService(t, id1, 1)
+		hs2, _ := newTestService(t, id2, 1)
+		hs1.SetUnderlay(func() ([]byte, error) {
+			return peer.AddrInfo{ID: id1}.MarshalJSON()
+		})
+
+		out, in := p2ptest.NewDuplexStream()
+
+		go func() {
+			_, _ = hs1.Handle(context.Background(), in, id2, accept)
+			_ = in.Close()
+		}()
+
+		p, err := hs2.Handshake(context.Background(), id1, out, accept)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Record == nil || p.Record.EthAddress != address1 {
+			t.Fatalf("unexpected peer record %+v", p.Record)
+		}
+		if _, err := peerrecord.Verify(*p.Record, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("invalid peer record", func(t *testing.T) {
+		id1, id2 := newPeerID(t), newPeerID(t)
+		hs1, _ := newTestService(t, id1, 1)
+		hs2, _ := newTestService(t, id2, 1)
+		// The record announces the underlay of another peer.
+		hs1.SetUnderlay(func() ([]byte, error) {
+			return peer.AddrInfo{ID: id2}.MarshalJSON()
+		})
+
+		out, in := p2ptest.NewDuplexStream()
+
+		go func() {
+			_, _ = hs1.Handle(context.Background(), in, id2, accept)
+			_ = in.Close()
+		}()
+
+		_, err := hs2.Handshake(context.Background(), id1, out, accept)
+		if !errors.Is(err, handshake.ErrInvalidPeerRecord) {
+			t.Fatalf("expected error %v, got %v", handshake.ErrInvalidPeerRecord, err)
+		}
+	})
+}
+
+func newPeerID(t *testing.T) core.PeerID {
+	t.Helper()
+
+	_, pubKey, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
 }
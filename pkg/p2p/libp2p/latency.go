@@ -0,0 +1,50 @@
+package libp2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+)
+
+const (
+	// latencyInterval is the interval at which the connected peers are
+	// pinged to measure their latency.
+	latencyInterval = time.Minute
+	pingTimeout     = 10 * time.Second
+)
+
+// Latency returns the measured round trip time to the peer. It is zero if
+// the peer is not connected or was not measured yet.
+func (s *Service) Latency(ethAddress common.Address) time.Duration {
+	peerID, found := s.peers.getPeerID(ethAddress)
+	if !found {
+		return 0
+	}
+	return s.host.Peerstore().LatencyEWMA(peerID)
+}
+
+// measureLatency pings the connected peers periodically. The round trip
+// times are recorded in the peerstore by the ping protocol.
+func (s *Service) measureLatency() {
+	ticker := time.NewTicker(latencyInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.baseCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, peerID := range s.peers.getPeerIDs() {
+			ctx, cancel := context.WithTimeout(s.baseCtx, pingTimeout)
+			res := <-ping.Ping(ctx, s.host, peerID)
+			cancel()
+			if res.Error != nil {
+				s.logger.Debug("failed to ping peer", "peer", peerID, "err", res.Error)
+			}
+		}
+	}
+}
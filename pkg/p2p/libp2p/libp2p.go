@@ -2,23 +2,30 @@ package libp2p
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
-	"net"
+	"math/big"
+	"os"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	ma "github.com/multiformats/go-multiaddr"
 	madns "github.com/multiformats/go-multiaddr-dns"
+	manet "github.com/multiformats/go-multiaddr/net"
 	"github.com/primevprotocol/mev-commit/pkg/keysigner"
-	"github.com/primevprotocol/mev-commit/pkg/util"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
@@ -29,8 +36,13 @@ import (
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	connmgr "github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/addressbook"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/blocklist"
 	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p/internal/handshake"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/policy"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/reputation"
 	"github.com/primevprotocol/mev-commit/pkg/signer"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -49,8 +61,23 @@ type Service struct {
 	notifier      p2p.Notifier
 	hsSvc         *handshake.Service
 	metrics       *metrics
-	blockMap      map[peer.ID]blockInfo
-	blockMu       sync.Mutex
+	authorizer    Authorizer
+	reporter      Reporter
+	limiter       *streamLimiter
+	addrFilter    *addrFilter
+	addressBook   *addressbook.Book
+	blocklist     *blocklist.Blocklist
+	bootstrapper  *bootstrapper
+	reachability  atomic.Int32
+	networkID     uint64
+	pubsub        *pubsub.PubSub
+	topics        map[string]*pubsub.Topic
+	topicsMu      sync.Mutex
+	protocols     []p2p.ProtocolInfo
+	protocolsMu   sync.RWMutex
+	version       string
+	encodings     []string
+	maxMsgSize    int
 }
 
 type ProviderRegistry interface {
@@ -58,7 +85,10 @@ type ProviderRegistry interface {
 }
 
 type Options struct {
-	KeySigner      keysigner.KeySigner
+	KeySigner keysigner.KeySigner
+	// IdentityKey is the libp2p key which determines the peer ID. A new
+	// Ed25519 key is generated if it is not set.
+	IdentityKey    libp2pcrypto.PrivKey
 	Secret         string
 	PeerType       p2p.PeerType
 	Register       handshake.ProviderRegistry
@@ -67,20 +97,107 @@ type Options struct {
 	Logger         *slog.Logger
 	MetricsReg     *prometheus.Registry
 	BootstrapAddrs []string
-	NatAddr        string
+	// ListenAddrs are the multiaddrs the node listens on, e.g.
+	// /ip4/0.0.0.0/udp/13522/quic-v1 or /ip6/::/tcp/13522/ws. The node
+	// listens on ListenAddr and ListenPort over TCP if it is empty.
+	ListenAddrs []string
+	// ExternalAddrs are multiaddrs under which the node is reachable from
+	// other networks, e.g. /dns4/node.example.com/tcp/13522. They are
+	// announced in addition to the listen addresses.
+	ExternalAddrs []string
+	// NatAddr is an external host:port which is announced over TCP.
+	NatAddr string
+	// RelayService lets the node relay connections to peers behind a NAT
+	// with circuit relay v2. It should only be set on public nodes.
+	RelayService bool
+	// StaticRelays are the multiaddrs of the relays through which the node
+	// is reachable while AutoNAT detects it as private.
+	StaticRelays []string
+	// HolePunching upgrades relayed connections to direct ones with DCUtR.
+	HolePunching bool
+	// PubSub enables GossipSub so that messages can be broadcast on topics.
+	PubSub bool
+	// ChainID and NetworkID have to match for peers to complete the
+	// handshake.
+	ChainID   *big.Int
+	NetworkID uint64
+	// Version of the node software and optional features advertised to
+	// peers in the handshake.
+	Version  string
+	Features []string
+	// Authorizer decides which verified peers are admitted. All peers are
+	// admitted if it is not set.
+	Authorizer Authorizer
+	// Blocklist keeps the blocked peers. An in-memory blocklist is used if
+	// it is not set.
+	Blocklist *blocklist.Blocklist
+	// Reporter is notified of misbehaving peers.
+	Reporter Reporter
+	// StreamRateLimits are the limits for inbound streams and forwarded
+	// pubsub messages per peer type. They override the limits of the
+	// protocols and topics, DefaultStreamRateLimit applies if neither
+	// defines a limit.
+	StreamRateLimits map[p2p.PeerType]p2p.RateLimit
+	// AddressBook keeps the connected peers so that they can be dialed
+	// again after a restart. An in-memory address book is used if it is not
+	// set.
+	AddressBook *addressbook.Book
+	// AllowCIDRs restricts dialed and accepted addresses to the ranges if it
+	// is not empty. DenyCIDRs are never dialed or accepted.
+	AllowCIDRs []string
+	DenyCIDRs  []string
+	// DenyPrivateAddrs prevents dialing and announcing private, loopback
+	// and unroutable addresses. It should be set on public deployments.
+	DenyPrivateAddrs bool
+	// MaxMessageSize is the maximum message size of protocols without a
+	// limit of their own. DefaultMaxMessageSize applies if it is zero.
+	MaxMessageSize int
+	// Compression lists the encodings offered to peers for the messages of
+	// protocol streams in order of preference, e.g. snappy or zstd. The
+	// messages are not compressed if it is empty.
+	Compression []string
+}
+
+// Reporter receives the events which affect the reputation of peers.
+type Reporter interface {
+	Report(ethAddress common.Address, ev reputation.Event)
+}
+
+// Authorizer decides if a peer which completed the handshake is admitted. The
+// connected argument is the number of peers of the same type connected in the
+// same direction.
+type Authorizer interface {
+	Authorize(peer p2p.Peer, dir p2p.Direction, connected int) error
 }
 
 func New(opts *Options) (*Service, error) {
-	privKey, err := opts.KeySigner.GetPrivateKey()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get priv key: %w", err)
+	if opts.ChainID == nil {
+		return nil, errors.New("chain ID is required")
 	}
-	defer opts.KeySigner.ZeroPrivateKey(privKey)
 
-	padded32BytePrivKey := util.PadKeyTo32Bytes(privKey.D)
-	libp2pKey, err := libp2pcrypto.UnmarshalSecp256k1PrivateKey(padded32BytePrivKey)
-	if err != nil {
-		return nil, err
+	libp2pKey := opts.IdentityKey
+	if libp2pKey == nil {
+		key, _, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate identity key: %w", err)
+		}
+		libp2pKey = key
+	}
+
+	book := opts.AddressBook
+	if book == nil {
+		var err error
+		if book, err = addressbook.Open("", 0, opts.Logger); err != nil {
+			return nil, err
+		}
+	}
+
+	bl := opts.Blocklist
+	if bl == nil {
+		var err error
+		if bl, err = blocklist.Open("", opts.Logger); err != nil {
+			return nil, err
+		}
 	}
 
 	connmgr, err := connmgr.NewConnManager(
@@ -97,10 +214,9 @@ func New(opts *Options) (*Service, error) {
 		return nil, err
 	}
 
-	var metrics = new(metrics)
+	metrics := newMetrics(opts.MetricsReg, defaultMetricsNamespace)
 	if opts.MetricsReg != nil {
 		rcmgr.MustRegisterWith(opts.MetricsReg)
-		metrics = newMetrics(opts.MetricsReg, defaultMetricsNamespace)
 	}
 
 	str, err := rcmgr.NewStatsTraceReporter()
@@ -115,28 +231,50 @@ func New(opts *Options) (*Service, error) {
 		return nil, err
 	}
 
-	conngtr := newGater(opts.Logger)
+	encodings, err := parseEncodings(opts.Compression)
+	if err != nil {
+		return nil, err
+	}
+	maxMsgSize := opts.MaxMessageSize
+	if maxMsgSize == 0 {
+		maxMsgSize = DefaultMaxMessageSize
+	}
 
-	var extMultiAddr ma.Multiaddr
-	if opts.NatAddr != "" {
-		addr, port, err := net.SplitHostPort(opts.NatAddr)
-		if err != nil {
-			return nil, err
-		}
-		extMultiAddr, err = ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%s", addr, port))
-		if err != nil {
-			return nil, err
-		}
+	bootstrapper, err := newBootstrapper(opts.BootstrapAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := newAddrFilter(opts.AllowCIDRs, opts.DenyCIDRs, opts.DenyPrivateAddrs)
+	if err != nil {
+		return nil, err
+	}
+	conngtr := newGater(filter, opts.Logger)
+
+	listen, err := listenAddrs(opts)
+	if err != nil {
+		return nil, err
+	}
+	external, err := externalAddrs(opts)
+	if err != nil {
+		return nil, err
 	}
 	addressFactory := func(addrs []ma.Multiaddr) []ma.Multiaddr {
-		if extMultiAddr != nil {
-			addrs = append(addrs, extMultiAddr)
+		if opts.DenyPrivateAddrs {
+			addrs = slices.DeleteFunc(slices.Clone(addrs), func(addr ma.Multiaddr) bool {
+				return !manet.IsPublicAddr(addr)
+			})
 		}
-		return addrs
+		return append(addrs, external...)
 	}
 
-	host, err := libp2p.New(
-		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/%s/tcp/%d", opts.ListenAddr, opts.ListenPort)),
+	nat, err := natOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := libp2p.New(append([]libp2p.Option{
+		libp2p.ListenAddrs(listen...),
 		libp2p.AddrsFactory(addressFactory),
 		libp2p.ConnectionGater(conngtr),
 		libp2p.Identity(libp2pKey),
@@ -148,7 +286,7 @@ func New(opts *Options) (*Service, error) {
 		libp2p.NATPortMap(),
 		libp2p.EnableNATService(),
 		libp2p.MultiaddrResolver(madns.DefaultResolver),
-	)
+	}, nat...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -157,22 +295,7 @@ func New(opts *Options) (*Service, error) {
 		opts.Logger.Info("p2p address", "addr", addr, "host_address", host.ID().Pretty())
 	}
 
-	ethAddress, err := GetEthAddressFromPeerID(host.ID())
-	if err != nil {
-		return nil, err
-	}
-
-	hsSvc, err := handshake.New(
-		opts.KeySigner,
-		opts.PeerType,
-		opts.Secret,
-		signer.New(),
-		opts.Register,
-		GetEthAddressFromPeerID,
-	)
-	if err != nil {
-		return nil, err
-	}
+	ethAddress := opts.KeySigner.GetAddress()
 
 	baseCtx, baseCtxCancel := context.WithCancel(context.Background())
 
@@ -181,26 +304,67 @@ func New(opts *Options) (*Service, error) {
 		baseCtxCancel: baseCtxCancel,
 		ethAddress:    ethAddress,
 		peerType:      opts.PeerType,
+		version:       opts.Version,
+		encodings:     encodings,
+		maxMsgSize:    maxMsgSize,
 		host:          host,
 		peers:         newPeerRegistry(),
-		hsSvc:         hsSvc,
 		logger:        opts.Logger,
 		metrics:       metrics,
-		blockMap:      make(map[peer.ID]blockInfo),
+		authorizer:    opts.Authorizer,
+		reporter:      opts.Reporter,
+		limiter:       newStreamLimiter(opts.StreamRateLimits),
+		addrFilter:    filter,
+		addressBook:   book,
+		blocklist:     bl,
+		bootstrapper:  bootstrapper,
+		networkID:     opts.NetworkID,
+		topics:        make(map[string]*pubsub.Topic),
+	}
+	if opts.PubSub {
+		// Messages are signed by the libp2p identity of the author. The
+		// payloads carry their own signatures which the validators verify.
+		s.pubsub, err = pubsub.NewGossipSub(baseCtx, host, pubsub.WithMessageSignaturePolicy(pubsub.StrictSign))
+		if err != nil {
+			baseCtxCancel()
+			return nil, errors.Join(err, host.Close())
+		}
 	}
+	s.hsSvc = handshake.New(handshake.Options{
+		KeySigner: opts.KeySigner,
+		PeerType:  opts.PeerType,
+		Passcode:  opts.Secret,
+		Signer:    signer.New(),
+		Register:  opts.Register,
+		SelfID:    host.ID(),
+		ChainID:   opts.ChainID,
+		NetworkID: opts.NetworkID,
+		Version:   opts.Version,
+		Protocols: s.supportedProtocols,
+		Features:  opts.Features,
+		Underlay:  s.selfUnderlay,
+	})
 	s.peers.setDisconnector(s)
 	conngtr.setBlocker(s)
 
 	host.Network().Notify(s.peers)
 
-	s.host.SetStreamHandler(handshake.ProtocolID(), s.handleConnectReq)
+	go s.measureLatency()
+	go s.watchReachability()
 
-	if len(opts.BootstrapAddrs) > 0 {
-		go s.startBootstrapper(opts.BootstrapAddrs)
-	}
 	return s, nil
 }
 
+// Start accepts handshakes and dials the bootnodes. It has to be called once
+// all stream handlers are added, peers keep the protocols announced in the
+// handshake.
+func (s *Service) Start() {
+	s.host.SetStreamHandler(handshake.ProtocolID(), s.handleConnectReq)
+
+	go s.startBootstrapper()
+	go s.refreshRecords()
+}
+
 func (s *Service) Close() error {
 	s.baseCtxCancel()
 	return s.host.Close()
@@ -214,29 +378,38 @@ func (s *Service) handleConnectReq(streamlibp2p network.Stream) {
 	peerID := streamlibp2p.Conn().RemotePeer()
 
 	stream := newStream(streamlibp2p, nil, nil)
-	peer, err := s.hsSvc.Handle(s.baseCtx, stream, peerID)
-	if err != nil {
-		s.logger.Error("error handling handshake", "err", err)
-		_ = streamlibp2p.Reset()
-		_ = s.host.Network().ClosePeer(peerID)
-		s.metrics.FailedIncomingHandshakeCount.Inc()
-		switch {
-		case errors.Is(err, handshake.ErrSignatureVerificationFailed):
-			s.blockPeer(peerID, 0, "signature verification failed")
-		case errors.Is(err, handshake.ErrObservedAddressMismatch):
-			s.blockPeer(peerID, 0, "address mismatch during handshake")
-		case errors.Is(err, handshake.ErrInsufficientStake):
-			s.blockPeer(peerID, 2*time.Minute, "insufficient stake")
+	peer, err := s.hsSvc.Handle(s.baseCtx, stream, peerID, func(p *p2p.Peer) error {
+		// Connected peers repeat the handshake to refresh their records.
+		if s.peers.updateRecord(peerID, p) {
+			return errPeerExists
 		}
+		exists, err := s.admit(streamlibp2p.Conn(), p, p2p.DirectionInbound)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errPeerExists
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errPeerExists):
+		// Both peers dialed each other at the same time or the peer
+		// refreshed its record. The dialer keeps the connection which is
+		// already known.
+		s.logger.Debug("peer already connected", "peer", peerID)
+		s.closeFailedHandshake(streamlibp2p, err)
 		return
-	}
-
-	if exists := s.peers.addPeer(streamlibp2p.Conn(), peer); exists {
-		s.logger.Warn("peer already exists", "peer", peer)
-		_ = streamlibp2p.Reset()
+	case err != nil:
+		s.logger.Error("error handling handshake", "err", err)
+		s.closeFailedHandshake(streamlibp2p, err)
+		s.metrics.FailedIncomingHandshakeCount.Inc()
+		s.blockFailedHandshake(peerID, err)
 		return
 	}
 
+	s.learnAddrs(peerID, *peer)
+	s.recordPeer(peerID, *peer)
 	if s.notifier != nil {
 		s.notifier.Connected(*peer)
 	}
@@ -244,7 +417,10 @@ func (s *Service) handleConnectReq(streamlibp2p network.Stream) {
 	s.logger.Info("peer connected (inbound)", "peer", peer)
 }
 
-func (s *Service) disconnected(p p2p.Peer) {
+func (s *Service) disconnected(peerID peer.ID, p p2p.Peer) {
+	s.limiter.disconnected(p.EthAddress)
+	// The addresses learned from the identify protocol are known by now.
+	s.recordPeer(peerID, p)
 	if s.notifier != nil {
 		s.notifier.Disconnected(p)
 	}
@@ -256,6 +432,8 @@ func (s *Service) Self() map[string]interface{} {
 		"Peer Type":        s.peerType.String(),
 		"Underlay":         s.host.ID().String(),
 		"Addresses":        s.host.Addrs(),
+		"Protocols":        s.supportedProtocols(),
+		"Reachability":     s.Reachability().String(),
 	}
 }
 
@@ -289,10 +467,73 @@ func matchProtocolIDWithSemver(
 	return supportedSemver.Major() == protoSemver.Major() && supportedSemver.Minor() >= protoSemver.Minor(), nil
 }
 
+func (s *Service) report(ethAddress common.Address, ev reputation.Event) {
+	if s.reporter != nil {
+		s.reporter.Report(ethAddress, ev)
+	}
+}
+
+// admit adds the peer which completed the handshake on the connection if it
+// is neither blocked nor rejected by the authorizer.
+func (s *Service) admit(c network.Conn, p *p2p.Peer, dir p2p.Direction) (exists bool, err error) {
+	if s.isAddressBlocked(p.EthAddress) {
+		return false, errPeerBlocked
+	}
+	return s.peers.authorizeAndAddPeer(c, p, dir, s.authorizer)
+}
+
+// closeFailedHandshake closes the connection to a peer which failed the
+// handshake. Peers running an incompatible version, rejected by the
+// authorization policy or connected already are told why they were rejected
+// first. Duplicate connections are left to the connection manager.
+func (s *Service) closeFailedHandshake(stream network.Stream, err error) {
+	var st *status.Status
+	switch {
+	case errors.Is(err, handshake.ErrIncompatibleProtocol):
+		st = status.New(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, policy.ErrDenied), errors.Is(err, policy.ErrNotAllowed),
+		errors.Is(err, errPeerBlocked):
+		st = status.New(codes.PermissionDenied, err.Error())
+	case errors.Is(err, policy.ErrTooManyPeers):
+		st = status.New(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, errPeerExists):
+		st = status.New(codes.AlreadyExists, err.Error())
+	}
+	if st != nil {
+		_ = stream.SetDeadline(time.Now().Add(time.Second))
+		if err := newMetadataStream(stream).WriteError(s.baseCtx, st); err == nil {
+			// Wait for the peer to read the error and close the stream, a
+			// reset would discard it.
+			_ = stream.CloseWrite()
+			_, _ = io.Copy(io.Discard, stream)
+		}
+	}
+	_ = stream.Reset()
+	// Only the connection of the handshake is closed, another one may be
+	// in use by the peer already.
+	if !errors.Is(err, errPeerExists) {
+		_ = stream.Conn().Close()
+	}
+}
+
+func (s *Service) supportedProtocols() []p2p.ProtocolInfo {
+	s.protocolsMu.RLock()
+	defer s.protocolsMu.RUnlock()
+
+	return slices.Clone(s.protocols)
+}
+
 func (s *Service) AddStreamHandlers(streams ...p2p.StreamDesc) {
 	for _, stream := range streams {
 		ss := stream
 
+		s.protocolsMu.Lock()
+		s.protocols = append(s.protocols, p2p.ProtocolInfo{
+			Name:    ss.Name,
+			Version: ss.Version,
+		})
+		s.protocolsMu.Unlock()
+
 		s.host.SetStreamHandlerMatch(
 			protocol.ID(ss.Name),
 			func(p protocol.ID) bool {
@@ -316,18 +557,51 @@ func (s *Service) AddStreamHandlers(streams ...p2p.StreamDesc) {
 				s.peers.addStream(peerID, streamlibp2p, cancel)
 				defer s.peers.removeStream(peerID, streamlibp2p)
 
-				mtdtStream := newMetadataStream(streamlibp2p)
+				ms := s.meteredStream(streamlibp2p, ss)
+				mtdtStream := newMetadataStream(ms)
 				headers, err := mtdtStream.ReadHeader(ctx)
 				if err != nil {
 					_ = streamlibp2p.Reset()
 					s.logger.Error("reading headers", "err", err)
+					if !errors.Is(err, io.EOF) && !errors.Is(err, context.Canceled) {
+						s.report(p.EthAddress, reputation.EventMalformedMessage)
+					}
+					return
+				}
+
+				if err := headers.Validate(); err != nil {
+					s.logger.Warn("invalid stream header", "peer", p, "protocol", ss.Name, "err", err)
+					s.report(p.EthAddress, reputation.EventMalformedMessage)
+					s.rejectStream(ctx, streamlibp2p, mtdtStream, status.New(codes.InvalidArgument, err.Error()))
+					return
+				}
+
+				if !s.limiter.allow(*p, ss) {
+					s.logger.Warn("stream rate limit exceeded", "peer", p, "protocol", ss.Name)
+					s.metrics.DroppedStreamsCount.WithLabelValues(ss.Name).Inc()
+					s.rejectStream(ctx, streamlibp2p, mtdtStream, status.New(codes.ResourceExhausted, "stream rate limit exceeded"))
+					return
+				}
+
+				// The peer gave up on the request already.
+				if timeout, ok := headers.Timeout(); ok && timeout <= 0 {
+					s.logger.Debug("stream deadline exceeded", "peer", p, "protocol", ss.Name, "requestID", headers.RequestID())
+					s.metrics.ExpiredStreamsCount.WithLabelValues(ss.Name).Inc()
+					s.rejectStream(ctx, streamlibp2p, mtdtStream, status.New(codes.DeadlineExceeded, "deadline exceeded"))
 					return
 				}
 
-				respHdrs := p2p.Header{}
+				// The handler runs until the deadline of the peer, the
+				// response is written with the stream context.
+				handlerCtx, cancelHandler := handlerContext(ctx, headers)
+				defer cancelHandler()
+
+				var respHdrs p2p.Header
 				if ss.Header != nil {
-					respHdrs = ss.Header(ctx, *p, headers)
+					respHdrs = ss.Header(handlerCtx, *p, headers)
 				}
+				encoding := s.negotiateEncoding(headers)
+				respHdrs = responseHeader(headers, respHdrs, encoding)
 
 				err = mtdtStream.WriteHeader(ctx, respHdrs)
 				if err != nil {
@@ -336,11 +610,19 @@ func (s *Service) AddStreamHandlers(streams ...p2p.StreamDesc) {
 					return
 				}
 
-				stream := newStream(streamlibp2p, headers, respHdrs)
+				stream := newProtocolStream(ms, headers, respHdrs, codecs[encoding], s.maxMessageSize(ss))
 
-				err = ss.Handler(ctx, *p, stream)
+				spanCtx, span := startHandlerSpan(handlerCtx, *p, ss, headers)
+				err = ss.Handler(spanCtx, *p, stream)
+				tracing.EndSpan(span, err)
 				if err != nil {
-					s.logger.Error("stream handler", "err", err)
+					s.logger.Error("stream handler", "err", err, "protocol", ss.Name, "requestID", headers.RequestID())
+					// Timeouts are only the fault of the peer if its own
+					// deadline didn't pass.
+					timeout := errors.Is(err, context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded)
+					if timeout && handlerCtx.Err() == nil {
+						s.report(p.EthAddress, reputation.EventStreamTimeout)
+					}
 					retErr, _ := status.FromError(err)
 					err = mtdtStream.WriteError(ctx, retErr)
 					if err != nil {
@@ -354,6 +636,28 @@ func (s *Service) AddStreamHandlers(streams ...p2p.StreamDesc) {
 	}
 }
 
+// rejectStream answers a stream with an error status before the handler runs.
+// The response header is written first as the peer expects it before any
+// message.
+func (s *Service) rejectStream(
+	ctx context.Context,
+	stream network.Stream,
+	mtdtStream p2p.MetadataStream,
+	st *status.Status,
+) {
+	if err := mtdtStream.WriteHeader(ctx, p2p.Header{}); err != nil {
+		s.logger.Error("writing headers", "err", err)
+		_ = stream.Reset()
+		return
+	}
+	if err := mtdtStream.WriteError(ctx, st); err != nil {
+		s.logger.Error("writing error", "err", err)
+		_ = stream.Reset()
+		return
+	}
+	_ = stream.Close()
+}
+
 func (s *Service) NewStream(
 	ctx context.Context,
 	peer p2p.Peer,
@@ -367,12 +671,17 @@ func (s *Service) NewStream(
 	}
 
 	streamID := protocol.ID(fmt.Sprintf("/%s/%s", stream.Name, stream.Version))
-	streamlibp2p, err := s.host.NewStream(ctx, peerID, streamID)
+	// Relayed connections are limited by the relay, they are used until hole
+	// punching upgrades them to direct connections.
+	streamCtx := network.WithUseTransient(ctx, string(streamID))
+	streamlibp2p, err := s.host.NewStream(streamCtx, peerID, streamID)
 	if err != nil {
 		return nil, err
 	}
 
-	mtdtStream := newMetadataStream(streamlibp2p)
+	headers = s.requestHeader(ctx, headers)
+	ms := s.meteredStream(streamlibp2p, stream)
+	mtdtStream := newMetadataStream(ms)
 	if err := mtdtStream.WriteHeader(ctx, headers); err != nil {
 		_ = streamlibp2p.Reset()
 		return nil, err
@@ -383,8 +692,19 @@ func (s *Service) NewStream(
 		_ = streamlibp2p.Reset()
 		return nil, err
 	}
+	// Peers without the standard headers don't echo the request ID.
+	if id := respHdrs.RequestID(); id != "" && id != headers.RequestID() {
+		_ = streamlibp2p.Reset()
+		return nil, fmt.Errorf("%w: request ID mismatch", p2p.ErrInvalidHeader)
+	}
+	encoding := respHdrs.ContentEncoding()
+	c, found := codecs[encoding]
+	if !found || (encoding != p2p.EncodingIdentity && !slices.Contains(headers.AcceptEncodings(), encoding)) {
+		_ = streamlibp2p.Reset()
+		return nil, fmt.Errorf("%w: unsupported content encoding %q", p2p.ErrInvalidHeader, encoding)
+	}
 
-	return newStream(streamlibp2p, headers, respHdrs), nil
+	return newProtocolStream(ms, headers, respHdrs, c, s.maxMessageSize(stream)), nil
 }
 
 func (s *Service) Connect(ctx context.Context, info []byte) (p2p.Peer, error) {
@@ -393,6 +713,7 @@ func (s *Service) Connect(ctx context.Context, info []byte) (p2p.Peer, error) {
 		return p2p.Peer{}, err
 	}
 
+	addrInfo.Addrs = s.addrFilter.filterDialable(addrInfo.Addrs)
 	if len(addrInfo.Addrs) == 0 {
 		return p2p.Peer{}, p2p.ErrNoAddresses
 	}
@@ -401,47 +722,83 @@ func (s *Service) Connect(ctx context.Context, info []byte) (p2p.Peer, error) {
 		return *p, nil
 	}
 
-	if err := s.host.Connect(ctx, addrInfo); err != nil {
+	// The peer may only be reachable through a relay.
+	dialCtx := network.WithUseTransient(ctx, handshake.ProtocolName)
+	if err := s.host.Connect(dialCtx, addrInfo); err != nil {
 		return p2p.Peer{}, err
 	}
 
-	streamlibp2p, err := s.host.NewStream(ctx, addrInfo.ID, handshake.ProtocolID())
+	streamlibp2p, err := s.host.NewStream(dialCtx, addrInfo.ID, handshake.ProtocolID())
 	if err != nil {
 		return p2p.Peer{}, err
 	}
 	stream := newStream(streamlibp2p, nil, nil)
 
-	p, err := s.hsSvc.Handshake(ctx, addrInfo.ID, stream)
-	if err != nil {
-		_ = s.host.Network().ClosePeer(addrInfo.ID)
-		s.metrics.FailedOutgoingHandshakeCount.Inc()
-		switch {
-		case errors.Is(err, handshake.ErrSignatureVerificationFailed):
-			s.blockPeer(addrInfo.ID, 0, "signature verification failed")
-		case errors.Is(err, handshake.ErrObservedAddressMismatch):
-			s.blockPeer(addrInfo.ID, 0, "address mismatch during handshake")
-		case errors.Is(err, handshake.ErrInsufficientStake):
-			s.blockPeer(addrInfo.ID, 5*time.Minute, "insufficient stake")
+	p, err := s.hsSvc.Handshake(ctx, addrInfo.ID, stream, func(p *p2p.Peer) error {
+		exists, err := s.admit(streamlibp2p.Conn(), p, p2p.DirectionOutbound)
+		if err != nil {
+			return err
+		}
+		if exists {
+			s.logger.Warn("peer already exists", "peer", p)
+		}
+		return nil
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		// The peer completed the handshake of its own connection to this
+		// node first, which is in use already.
+		_ = streamlibp2p.Reset()
+		if p, found := s.peers.isConnected(addrInfo.ID); found {
+			s.logger.Debug("peer already connected", "peer", p)
+			return *p, nil
 		}
 		return p2p.Peer{}, err
 	}
-
-	if exists := s.peers.addPeer(streamlibp2p.Conn(), p); exists {
-		s.logger.Warn("peer already exists", "peer", p)
+	if err != nil {
+		s.closeFailedHandshake(streamlibp2p, err)
+		s.metrics.FailedOutgoingHandshakeCount.Inc()
+		s.blockFailedHandshake(addrInfo.ID, err)
+		return p2p.Peer{}, err
 	}
 
 	s.host.Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, peerstore.PermanentAddrTTL)
+	s.learnAddrs(addrInfo.ID, *p)
+	s.recordPeer(addrInfo.ID, *p)
 	s.logger.Info("peer connected (outbound)", "peer", p)
 
 	return *p, nil
 }
 
-func (s *Service) GetPeerInfo(p p2p.Peer) ([]byte, error) {
+// GetPeerInfo returns the peer record which the peer signed during the
+// handshake.
+func (s *Service) GetPeerInfo(p p2p.Peer) (p2p.PeerInfo, error) {
 	peerID, found := s.peers.getPeerID(p.EthAddress)
 	if !found {
-		return nil, p2p.ErrPeerNotFound
+		return p2p.PeerInfo{}, p2p.ErrPeerNotFound
 	}
 
-	peerInfo := s.host.Peerstore().PeerInfo(peerID)
-	return peerInfo.MarshalJSON()
+	connected, found := s.peers.getPeer(peerID)
+	if !found {
+		return p2p.PeerInfo{}, p2p.ErrPeerNotFound
+	}
+	if connected.Record == nil {
+		return p2p.PeerInfo{}, p2p.ErrNoPeerRecord
+	}
+	return *connected.Record, nil
+}
+
+// selfUnderlay returns the address info which is signed in the peer record
+// of the node.
+func (s *Service) selfUnderlay() ([]byte, error) {
+	return peer.AddrInfo{ID: s.host.ID(), Addrs: s.host.Addrs()}.MarshalJSON()
+}
+
+// Peers returns the connected peers of all types.
+func (s *Service) Peers() []p2p.Peer {
+	peers := s.peers.getPeers()
+	res := make([]p2p.Peer, 0, len(peers))
+	for _, p := range peers {
+		res = append(res, *p)
+	}
+	return res
 }
@@ -5,20 +5,33 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"math/big"
 	"os"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	relayclient "github.com/libp2p/go-libp2p/p2p/protocol/circuitv2/client"
+	"github.com/multiformats/go-multiaddr"
 	mockkeysigner "github.com/primevprotocol/mev-commit/pkg/keysigner/mock"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
 	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/peerrecord"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/policy"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -40,7 +53,44 @@ func newTestLogger(t *testing.T, w io.Writer) *slog.Logger {
 	return slog.New(testLogger)
 }
 
-func newTestService(t *testing.T) *libp2p.Service {
+// counterValue returns the value of the counter with the protocol label.
+func counterValue(t *testing.T, reg *prometheus.Registry, name, protocol string) float64 {
+	t.Helper()
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "protocol" && l.GetValue() == protocol {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+type authorizerFunc func(p2p.Peer, p2p.Direction, int) error
+
+func (f authorizerFunc) Authorize(p p2p.Peer, dir p2p.Direction, connected int) error {
+	return f(p, dir, connected)
+}
+
+func newTestService(t *testing.T, opts ...func(*libp2p.Options)) *libp2p.Service {
+	t.Helper()
+
+	svc := newUnstartedTestService(t, opts...)
+	svc.Start()
+	return svc
+}
+
+func newUnstartedTestService(t *testing.T, opts ...func(*libp2p.Options)) *libp2p.Service {
 	t.Helper()
 
 	privKey, err := crypto.GenerateKey()
@@ -49,7 +99,7 @@ func newTestService(t *testing.T) *libp2p.Service {
 	}
 	address := crypto.PubkeyToAddress(privKey.PublicKey)
 	ks := mockkeysigner.NewMockKeySigner(privKey, address)
-	svc, err := libp2p.New(&libp2p.Options{
+	options := &libp2p.Options{
 		KeySigner:  ks,
 		Secret:     "test",
 		ListenPort: 0,
@@ -57,25 +107,1277 @@ func newTestService(t *testing.T) *libp2p.Service {
 		PeerType:   p2p.PeerTypeProvider,
 		Register:   &testRegistry{},
 		Logger:     newTestLogger(t, os.Stdout),
-	})
+		ChainID:    big.NewInt(17864),
+		NetworkID:  1,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	svc, err := libp2p.New(options)
 	if err != nil {
 		t.Fatal(err)
 	}
 	return svc
 }
 
-func TestP2PService(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping protocols test in short mode")
-	}
+func TestP2PService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping protocols test in short mode")
+	}
+
+	t.Run("new and close", func(t *testing.T) {
+		svc := newTestService(t)
+
+		err := svc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("start", func(t *testing.T) {
+		svc := newUnstartedTestService(t)
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Handshakes are only accepted once the protocols are registered.
+		if _, err := client.Connect(context.Background(), svAddr); err == nil {
+			t.Fatal("expected handshake to fail before start")
+		}
+		if len(svc.BlockedPeers()) != 0 || len(client.BlockedPeers()) != 0 {
+			t.Fatal("expected no blocked peers")
+		}
+
+		handler := func(context.Context, p2p.Peer, p2p.Stream) error { return nil }
+		svc.AddStreamHandlers(p2p.StreamDesc{Name: "test", Version: "1.0.0", Handler: handler})
+		svc.Start()
+
+		p, err := client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Capabilities == nil || !p.Capabilities.SupportsProtocol("test", "1.0.0") {
+			t.Fatalf("expected test protocol in capabilities, got %+v", p.Capabilities)
+		}
+	})
+
+	t.Run("incompatible protocol", func(t *testing.T) {
+		svc := newTestService(t)
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		handler := func(context.Context, p2p.Peer, p2p.Stream) error { return nil }
+		svc.AddStreamHandlers(p2p.StreamDesc{Name: "test", Version: "1.0.0", Handler: handler})
+		client.AddStreamHandlers(p2p.StreamDesc{Name: "test", Version: "2.0.0", Handler: handler})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = client.Connect(context.Background(), svAddr)
+		if err == nil || !strings.Contains(err.Error(), "incompatible protocol") {
+			t.Fatalf("expected incompatible protocol error, got %v", err)
+		}
+	})
+
+	t.Run("simultaneous connect", func(t *testing.T) {
+		svc := newTestService(t)
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := peer.AddrInfo{ID: svc.HostID(), Addrs: svc.HostAddrs()}.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		clientAddr, err := peer.AddrInfo{ID: client.HostID(), Addrs: client.HostAddrs()}.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, errs[0] = client.Connect(context.Background(), svAddr)
+		}()
+		go func() {
+			defer wg.Done()
+			_, errs[1] = svc.Connect(context.Background(), clientAddr)
+		}()
+		wg.Wait()
+
+		if err := errors.Join(errs...); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range []*libp2p.Service{svc, client} {
+			if len(s.BlockedPeers()) != 0 {
+				t.Fatalf("expected no blocked peers, got %+v", s.BlockedPeers())
+			}
+			if len(s.Peers()) != 1 || s.PeerCount() != 1 {
+				t.Fatalf("expected connected peer, got %+v", s.Peers())
+			}
+		}
+	})
+
+	t.Run("not authorized", func(t *testing.T) {
+		var denied atomic.Bool
+		denied.Store(true)
+		svc := newTestService(t, func(o *libp2p.Options) {
+			o.Authorizer = authorizerFunc(func(p2p.Peer, p2p.Direction, int) error {
+				if denied.Load() {
+					return policy.ErrDenied
+				}
+				return nil
+			})
+		})
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = client.Connect(context.Background(), svAddr)
+		if err == nil || !strings.Contains(err.Error(), policy.ErrDenied.Error()) {
+			t.Fatalf("expected error %v, got %v", policy.ErrDenied, err)
+		}
+		if len(svc.BlockedPeers()) != 0 {
+			t.Fatalf("expected no blocked peers, got %v", svc.BlockedPeers())
+		}
+
+		// A reloaded policy applies to the next handshake.
+		denied.Store(false)
+		if _, err := client.Connect(context.Background(), svAddr); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("max peers per direction", func(t *testing.T) {
+		svc := newTestService(t, func(o *libp2p.Options) {
+			o.Authorizer = authorizerFunc(func(_ p2p.Peer, _ p2p.Direction, connected int) error {
+				if connected >= 1 {
+					return policy.ErrTooManyPeers
+				}
+				return nil
+			})
+		})
+		outbound := newTestService(t)
+		inbound1 := newTestService(t)
+		inbound2 := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), outbound.Close(), inbound1.Close(), inbound2.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		outboundAddr, err := outbound.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := svc.Connect(context.Background(), outboundAddr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := inbound1.Connect(context.Background(), svAddr); err != nil {
+			t.Fatal(err)
+		}
+		_, err = inbound2.Connect(context.Background(), svAddr)
+		if err == nil || !strings.Contains(err.Error(), policy.ErrTooManyPeers.Error()) {
+			t.Fatalf("expected error %v, got %v", policy.ErrTooManyPeers, err)
+		}
+	})
+
+	t.Run("max peers concurrent handshakes", func(t *testing.T) {
+		svc := newTestService(t, func(o *libp2p.Options) {
+			o.Authorizer = authorizerFunc(func(_ p2p.Peer, _ p2p.Direction, connected int) error {
+				// Widen the window between the check and the insertion of
+				// the peer.
+				time.Sleep(10 * time.Millisecond)
+				if connected >= 1 {
+					return policy.ErrTooManyPeers
+				}
+				return nil
+			})
+		})
+		clients := make([]*libp2p.Service, 5)
+		for i := range clients {
+			clients[i] = newTestService(t)
+		}
+
+		t.Cleanup(func() {
+			errs := []error{svc.Close()}
+			for _, c := range clients {
+				errs = append(errs, c.Close())
+			}
+			if err := errors.Join(errs...); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var (
+			wg        sync.WaitGroup
+			connected atomic.Int32
+		)
+		for _, c := range clients {
+			wg.Add(1)
+			go func(c *libp2p.Service) {
+				defer wg.Done()
+				if _, err := c.Connect(context.Background(), svAddr); err == nil {
+					connected.Add(1)
+				}
+			}(c)
+		}
+		wg.Wait()
+
+		if n := connected.Load(); n != 1 {
+			t.Fatalf("expected 1 client to connect, got %d", n)
+		}
+		if n := len(svc.Peers()); n != 1 {
+			t.Fatalf("expected 1 peer, got %d", n)
+		}
+	})
+
+	t.Run("refresh record", func(t *testing.T) {
+		svc := newTestService(t)
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Connect(context.Background(), svAddr); err != nil {
+			t.Fatal(err)
+		}
+
+		clientInfo, err := svc.GetPeerInfo(client.Peer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		svcInfo, err := client.GetPeerInfo(svc.Peer())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The timestamps of the records have a resolution of a second.
+		time.Sleep(1100 * time.Millisecond)
+		if err := client.RefreshRecord(svc.HostID()); err != nil {
+			t.Fatal(err)
+		}
+
+		refreshed, err := svc.GetPeerInfo(client.Peer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if refreshed.Timestamp <= clientInfo.Timestamp {
+			t.Fatalf("expected client record to be refreshed, got timestamp %d", refreshed.Timestamp)
+		}
+		refreshed, err = client.GetPeerInfo(svc.Peer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if refreshed.Timestamp <= svcInfo.Timestamp {
+			t.Fatalf("expected service record to be refreshed, got timestamp %d", refreshed.Timestamp)
+		}
+		if len(svc.Peers()) != 1 || len(svc.BlockedPeers()) != 0 {
+			t.Fatal("expected connection to be kept")
+		}
+	})
+
+	t.Run("block and unblock", func(t *testing.T) {
+		svc := newTestService(t)
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		clientAddr := client.Peer().EthAddress.Hex()
+		if err := svc.Block(clientAddr, time.Hour, "test"); err != nil {
+			t.Fatal(err)
+		}
+		if err := svc.Block("invalid", time.Hour, "test"); !errors.Is(err, libp2p.ErrInvalidPeer) {
+			t.Fatalf("expected error %v, got %v", libp2p.ErrInvalidPeer, err)
+		}
+
+		_, err = client.Connect(context.Background(), svAddr)
+		if err == nil || !strings.Contains(err.Error(), "blocklisted") {
+			t.Fatalf("expected blocklisted error, got %v", err)
+		}
+
+		blocked := svc.BlockedPeers()
+		if len(blocked) != 1 || blocked[0].Peer.Hex() != clientAddr || blocked[0].Reason != "test" {
+			t.Fatalf("unexpected blocked peers %+v", blocked)
+		}
+
+		if err := svc.Unblock(clientAddr); err != nil {
+			t.Fatal(err)
+		}
+		history := svc.BlocklistHistory()
+		if len(history) != 2 || !history[0].Entry.Manual {
+			t.Fatalf("unexpected history %+v", history)
+		}
+	})
+
+	t.Run("stream rate limit", func(t *testing.T) {
+		svc := newTestService(t)
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		stream := p2p.StreamDesc{
+			Name:    "test",
+			Version: "1.0.0",
+			Handler: func(ctx context.Context, _ p2p.Peer, str p2p.Stream) error {
+				return str.WriteMsg(ctx, &wrapperspb.StringValue{Value: "ok"})
+			},
+			RateLimits: map[p2p.PeerType]p2p.RateLimit{
+				p2p.PeerTypeProvider: {Rate: 0.001, Burst: 1},
+			},
+		}
+		svc.AddStreamHandlers(stream)
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, code := range []codes.Code{codes.OK, codes.ResourceExhausted} {
+			str, err := client.NewStream(context.Background(), p, nil, stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = str.ReadMsg(context.Background(), new(wrapperspb.StringValue))
+			if status.Code(err) != code {
+				t.Fatalf("stream %d: expected code %s, got %v", i, code, err)
+			}
+			_ = str.Close()
+		}
+
+		// The buckets are kept across reconnects.
+		if err := client.ClosePeer(svc.HostID()); err != nil {
+			t.Fatal(err)
+		}
+		start := time.Now()
+		for len(svc.Peers()) != 0 || len(client.Peers()) != 0 {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out waiting for disconnect")
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		p, err = client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		str, err := client.NewStream(context.Background(), p, nil, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = str.ReadMsg(context.Background(), new(wrapperspb.StringValue))
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Fatalf("expected code %s after reconnect, got %v", codes.ResourceExhausted, err)
+		}
+		_ = str.Close()
+	})
+
+	t.Run("operator stream rate limit", func(t *testing.T) {
+		svc := newTestService(t, func(o *libp2p.Options) {
+			o.StreamRateLimits = map[p2p.PeerType]p2p.RateLimit{
+				p2p.PeerTypeProvider: {Rate: 0.001, Burst: 1},
+			}
+		})
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		stream := p2p.StreamDesc{
+			Name:    "test",
+			Version: "1.0.0",
+			Handler: func(ctx context.Context, _ p2p.Peer, str p2p.Stream) error {
+				return str.WriteMsg(ctx, &wrapperspb.StringValue{Value: "ok"})
+			},
+			RateLimits: map[p2p.PeerType]p2p.RateLimit{
+				p2p.PeerTypeProvider: {Rate: 1000, Burst: 1000},
+			},
+		}
+		svc.AddStreamHandlers(stream)
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, code := range []codes.Code{codes.OK, codes.ResourceExhausted} {
+			str, err := client.NewStream(context.Background(), p, nil, stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = str.ReadMsg(context.Background(), new(wrapperspb.StringValue))
+			if status.Code(err) != code {
+				t.Fatalf("stream %d: expected code %s, got %v", i, code, err)
+			}
+			_ = str.Close()
+		}
+	})
+
+	t.Run("trace context", func(t *testing.T) {
+		if _, err := tracing.New(tracing.Options{}); err != nil {
+			t.Fatal(err)
+		}
+
+		svc := newTestService(t)
+		client := newTestService(t)
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		stream := p2p.StreamDesc{
+			Name:    "test",
+			Version: "1.0.0",
+			Handler: func(ctx context.Context, _ p2p.Peer, str p2p.Stream) error {
+				traceID := trace.SpanContextFromContext(ctx).TraceID()
+				return str.WriteMsg(ctx, &wrapperspb.StringValue{Value: traceID.String()})
+			},
+		}
+		svc.AddStreamHandlers(stream)
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1, 2, 3},
+			SpanID:     trace.SpanID{4, 5, 6},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+		str, err := client.NewStream(ctx, p, nil, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer str.Close()
+
+		msg := new(wrapperspb.StringValue)
+		if err := str.ReadMsg(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg.Value != sc.TraceID().String() {
+			t.Fatalf("expected trace ID %s, got %s", sc.TraceID(), msg.Value)
+		}
+	})
+
+	t.Run("standard headers", func(t *testing.T) {
+		svc := newTestService(t)
+		client := newTestService(t, func(o *libp2p.Options) { o.Version = "v0.0.1" })
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		headers := make(chan p2p.Header, 1)
+		deadlines := make(chan time.Time, 1)
+		stream := p2p.StreamDesc{
+			Name:    "test",
+			Version: "1.0.0",
+			Handler: func(ctx context.Context, _ p2p.Peer, str p2p.Stream) error {
+				hdr := p2p.HeaderFromContext(ctx)
+				// The handler runs until the deadline of the peer.
+				if _, ok := hdr.Timeout(); ok {
+					deadline, ok := ctx.Deadline()
+					if !ok {
+						return errors.New("no deadline in handler context")
+					}
+					deadlines <- deadline
+				}
+				headers <- hdr
+				return str.WriteMsg(ctx, &wrapperspb.StringValue{Value: p2p.RequestIDFromContext(ctx)})
+			},
+		}
+		svc.AddStreamHandlers(stream)
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deadline := time.Now().Add(time.Minute)
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+		ctx = p2p.WithRequestID(ctx, "req-1")
+		ctx = p2p.WithIdempotencyKey(ctx, "key-1")
+
+		str, err := client.NewStream(ctx, p, nil, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := new(wrapperspb.StringValue)
+		if err := str.ReadMsg(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+		_ = str.Close()
+		if msg.Value != "req-1" {
+			t.Fatalf("expected request ID req-1 in handler context, got %q", msg.Value)
+		}
+
+		hdr := <-headers
+		if hdr.RequestID() != "req-1" || hdr.IdempotencyKey() != "key-1" || hdr.ClientVersion() != "v0.0.1" {
+			t.Fatalf("unexpected header %v", hdr)
+		}
+		if timeout, ok := hdr.Timeout(); !ok || timeout <= 0 || timeout > time.Minute {
+			t.Fatalf("expected timeout of at most a minute, got %s", timeout)
+		}
+		<-deadlines
+		if encodings := hdr.AcceptEncodings(); len(encodings) != 1 || encodings[0] != p2p.EncodingIdentity {
+			t.Fatalf("unexpected encodings %v", encodings)
+		}
+
+		// Streams get a new request ID without one in the context.
+		str, err = client.NewStream(context.Background(), p, nil, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := str.ReadMsg(context.Background(), msg); err != nil {
+			t.Fatal(err)
+		}
+		_ = str.Close()
+		if hdr := <-headers; hdr.RequestID() == "" || hdr.RequestID() == "req-1" {
+			t.Fatalf("expected new request ID, got %q", hdr.RequestID())
+		}
+
+		// Deadlines of peers are bounded.
+		distant := p2p.Header{}
+		distant.SetDeadline(time.Now().Add(1000 * time.Hour))
+		str, err = client.NewStream(context.Background(), p, distant, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := str.ReadMsg(context.Background(), msg); err != nil {
+			t.Fatal(err)
+		}
+		_ = str.Close()
+		<-headers
+		if deadline := <-deadlines; time.Until(deadline) > time.Minute {
+			t.Fatalf("expected handler deadline within a minute, got %s", deadline)
+		}
+
+		// Streams of peers which gave up already are rejected.
+		expired := p2p.Header{}
+		expired.SetDeadline(time.Now().Add(-time.Second))
+		str, err = client.NewStream(context.Background(), p, expired, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = str.ReadMsg(context.Background(), msg)
+		if status.Code(err) != codes.DeadlineExceeded {
+			t.Fatalf("expected code %s, got %v", codes.DeadlineExceeded, err)
+		}
+		_ = str.Close()
+
+		// Malformed standard headers are rejected.
+		invalid := p2p.Header{p2p.HeaderDeadline: structpb.NewStringValue("tomorrow")}
+		str, err = client.NewStream(context.Background(), p, invalid, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = str.ReadMsg(context.Background(), msg)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected code %s, got %v", codes.InvalidArgument, err)
+		}
+		_ = str.Close()
+	})
+
+	t.Run("compression", func(t *testing.T) {
+		reg := prometheus.NewRegistry()
+		svc := newTestService(t, func(o *libp2p.Options) {
+			o.Compression = []string{p2p.EncodingZstd, p2p.EncodingSnappy}
+			o.MetricsReg = reg
+		})
+		client := newTestService(t, func(o *libp2p.Options) {
+			o.Compression = []string{p2p.EncodingSnappy}
+		})
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		payload := strings.Repeat("a", 64<<10)
+		errs := make(chan error, 1)
+		stream := p2p.StreamDesc{
+			Name:           "test",
+			Version:        "1.0.0",
+			MaxMessageSize: 128 << 10,
+			Handler: func(ctx context.Context, _ p2p.Peer, str p2p.Stream) error {
+				msg := new(wrapperspb.StringValue)
+				err := str.ReadMsg(ctx, msg)
+				errs <- err
+				if err != nil {
+					return err
+				}
+				return str.WriteMsg(ctx, &wrapperspb.StringValue{Value: payload})
+			},
+		}
+		svc.AddStreamHandlers(stream)
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		str, err := client.NewStream(context.Background(), p, nil, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := str.WriteMsg(context.Background(), &wrapperspb.StringValue{Value: "ping"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+		msg := new(wrapperspb.StringValue)
+		if err := str.ReadMsg(context.Background(), msg); err != nil {
+			t.Fatal(err)
+		}
+		_ = str.Close()
+		if msg.Value != payload {
+			t.Fatal("unexpected message")
+		}
+
+		// The messages are compressed with the encoding of the client.
+		written := counterValue(t, reg, "mev_commit_libp2p_stream_written_bytes_count", "test")
+		if written == 0 || written > 8<<10 {
+			t.Fatalf("expected compressed messages, got %v bytes written", written)
+		}
+		if read := counterValue(t, reg, "mev_commit_libp2p_stream_read_bytes_count", "test"); read == 0 {
+			t.Fatal("expected bytes read")
+		}
+
+		// Messages larger than the limit of the handler are rejected.
+		large := stream
+		large.MaxMessageSize = 0
+		str, err = client.NewStream(context.Background(), p, nil, large)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := str.WriteMsg(context.Background(), &wrapperspb.StringValue{Value: payload + payload}); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-errs; !errors.Is(err, p2p.ErrMessageTooLarge) {
+			t.Fatalf("expected error %v, got %v", p2p.ErrMessageTooLarge, err)
+		}
+		if err := str.ReadMsg(context.Background(), msg); status.Code(err) != codes.Unknown {
+			t.Fatalf("expected handler error, got %v", err)
+		}
+		_ = str.Close()
+	})
+
+	t.Run("address filter", func(t *testing.T) {
+		svc := newTestService(t)
+		client := newTestService(t, func(o *libp2p.Options) {
+			o.DenyCIDRs = []string{"8.8.0.0/16"}
+			o.DenyPrivateAddrs = true
+		})
+
+		t.Cleanup(func() {
+			err := errors.Join(svc.Close(), client.Close())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		info := peer.AddrInfo{
+			ID: svc.HostID(),
+			Addrs: []multiaddr.Multiaddr{
+				multiaddr.StringCast("/ip4/10.1.2.3/tcp/13522"),
+				multiaddr.StringCast("/ip4/127.0.0.1/tcp/13522"),
+				multiaddr.StringCast("/ip4/8.8.8.8/tcp/13522"),
+				multiaddr.StringCast("/ip4/1.1.1.1/tcp/13522"),
+			},
+		}
+		underlay, err := info.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		filtered, err := client.FilterUnderlay(underlay)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := info.UnmarshalJSON(filtered); err != nil {
+			t.Fatal(err)
+		}
+		if len(info.Addrs) != 1 || info.Addrs[0].String() != "/ip4/1.1.1.1/tcp/13522" {
+			t.Fatalf("unexpected addresses %v", info.Addrs)
+		}
+
+		// The local addresses of the service are private.
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Connect(context.Background(), svAddr); !errors.Is(err, p2p.ErrNoAddresses) {
+			t.Fatalf("expected error %v, got %v", p2p.ErrNoAddresses, err)
+		}
+	})
 
-	t.Run("new and close", func(t *testing.T) {
+	t.Run("known peers", func(t *testing.T) {
 		svc := newTestService(t)
+		client := newTestService(t)
 
-		err := svc.Close()
+		t.Cleanup(func() {
+			if err := client.Close(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := client.Connect(context.Background(), svAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(client.KnownPeers()) != 0 {
+			t.Fatal("expected connected peers to be excluded")
+		}
+
+		if err := svc.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Now()
+		for len(client.KnownPeers()) == 0 {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out waiting for the peer to disconnect")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		known := client.KnownPeers()
+		if len(known) != 1 || known[0].EthAddress != p.EthAddress {
+			t.Fatalf("unexpected known peers %+v", known)
+		}
+		var info peer.AddrInfo
+		if err := info.UnmarshalJSON(known[0].Underlay); err != nil {
+			t.Fatal(err)
+		}
+		if info.ID != svc.HostID() || len(info.Addrs) == 0 {
+			t.Fatalf("unexpected underlay %+v", info)
+		}
+	})
+
+	t.Run("multiple transports", func(t *testing.T) {
+		svc := newTestService(t, func(o *libp2p.Options) {
+			o.ListenAddrs = []string{
+				"/ip4/127.0.0.1/tcp/0",
+				"/ip4/127.0.0.1/udp/0/quic-v1",
+				"/ip4/127.0.0.1/tcp/0/ws",
+			}
+			o.ExternalAddrs = []string{"/dns4/node.example.com/tcp/13522"}
+		})
+		client := newTestService(t, func(o *libp2p.Options) {
+			o.ListenAddrs = []string{"/ip6/::1/tcp/0"}
+		})
+
+		t.Cleanup(func() {
+			if err := errors.Join(svc.Close(), client.Close()); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		// Only the websocket address is dialed, the others are learned from
+		// the peer record.
+		var wsAddr multiaddr.Multiaddr
+		for _, addr := range svc.HostAddrs() {
+			if _, err := addr.ValueForProtocol(multiaddr.P_WS); err == nil {
+				wsAddr = addr
+			}
+		}
+		if wsAddr == nil {
+			t.Fatalf("expected a websocket address, got %v", svc.HostAddrs())
+		}
+		underlay, err := peer.AddrInfo{ID: svc.HostID(), Addrs: []multiaddr.Multiaddr{wsAddr}}.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := client.Connect(context.Background(), underlay)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var info peer.AddrInfo
+		if err := info.UnmarshalJSON(p.Record.Underlay); err != nil {
+			t.Fatal(err)
+		}
+		want := []string{"/quic-v1", "/dns4/node.example.com/tcp/13522"}
+		for _, w := range want {
+			found := false
+			for _, addr := range client.PeerAddrs(svc.HostID()) {
+				found = found || strings.Contains(addr.String(), w)
+			}
+			if !found {
+				t.Fatalf("expected an address with %s, got %v", w, client.PeerAddrs(svc.HostID()))
+			}
+		}
+		if len(info.Addrs) != 4 {
+			t.Fatalf("expected 4 addresses in the peer record, got %v", info.Addrs)
+		}
+	})
+
+	t.Run("nat traversal", func(t *testing.T) {
+		relay := newTestService(t, func(o *libp2p.Options) {
+			o.RelayService = true
+		})
+		svc := newTestService(t, func(o *libp2p.Options) {
+			o.StaticRelays = []string{relay.AddrString()}
+			o.HolePunching = true
+		})
+
+		t.Cleanup(func() {
+			if err := errors.Join(relay.Close(), svc.Close()); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		if r := svc.Self()["Reachability"]; r != "Unknown" {
+			t.Fatalf("expected unknown reachability, got %v", r)
+		}
+
+		// AutoNAT doesn't detect the reachability in the test. The relay
+		// service only runs on public nodes and the service reserves a slot
+		// on the relay itself.
+		emitter, err := relay.Host().EventBus().Emitter(new(event.EvtLocalReachabilityChanged))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = emitter.Emit(event.EvtLocalReachabilityChanged{Reachability: network.ReachabilityPublic})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = emitter.Close()
+
+		relayInfo := peer.AddrInfo{ID: relay.HostID(), Addrs: relay.HostAddrs()}
+		start := time.Now()
+		for {
+			_, err := relayclient.Reserve(context.Background(), svc.Host(), relayInfo)
+			if err == nil {
+				break
+			}
+			if time.Since(start) > 5*time.Second {
+				t.Fatal(err)
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+
+		received := make(chan string, 1)
+		stream := p2p.StreamDesc{
+			Name:    "test",
+			Version: "1.0.0",
+			Handler: func(ctx context.Context, _ p2p.Peer, str p2p.Stream) error {
+				msg := new(wrapperspb.StringValue)
+				if err := str.ReadMsg(ctx, msg); err != nil {
+					return err
+				}
+				received <- msg.Value
+				return nil
+			},
+		}
+		svc.AddStreamHandlers(stream)
+
+		client := newTestService(t)
+		t.Cleanup(func() {
+			if err := client.Close(); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		circuit, err := multiaddr.NewMultiaddr(relay.AddrString() + "/p2p-circuit")
+		if err != nil {
+			t.Fatal(err)
+		}
+		relayedAddr, err := peer.AddrInfo{ID: svc.HostID(), Addrs: []multiaddr.Multiaddr{circuit}}.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The handshake and the streams work on the relayed connection.
+		p, err := client.Connect(context.Background(), relayedAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.EthAddress != svc.Peer().EthAddress {
+			t.Fatalf("expected peer %s, got %s", svc.Peer().EthAddress, p.EthAddress)
+		}
+		str, err := client.NewStream(context.Background(), p, nil, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := str.WriteMsg(context.Background(), &wrapperspb.StringValue{Value: "relayed"}); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case msg := <-received:
+			if msg != "relayed" {
+				t.Fatalf("expected relayed message, got %s", msg)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for message")
+		}
+		_ = str.Close()
+		if len(client.BlockedPeers()) != 0 || len(svc.BlockedPeers()) != 0 {
+			t.Fatal("expected no blocked peers")
+		}
+
+		_, err = libp2p.New(&libp2p.Options{
+			ChainID:      big.NewInt(17864),
+			StaticRelays: []string{"/ip4/127.0.0.1/tcp/13522"},
+			Logger:       newTestLogger(t, os.Stdout),
+		})
+		if err == nil {
+			t.Fatal("expected static relay without peer ID to be rejected")
+		}
+	})
+
+	t.Run("pubsub", func(t *testing.T) {
+		svc := newTestService(t, func(o *libp2p.Options) { o.PubSub = true })
+		client := newTestService(t, func(o *libp2p.Options) { o.PubSub = true })
+
+		t.Cleanup(func() {
+			if err := errors.Join(svc.Close(), client.Close()); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		validate := func(_ context.Context, _ p2p.Peer, data []byte) error {
+			if string(data) == "invalid" {
+				return errors.New("invalid message")
+			}
+			return nil
+		}
+		received := make(chan string, 10)
+		err := svc.AddTopics(p2p.TopicDesc{
+			Name:     "test",
+			Validate: validate,
+			Handler: func(_ context.Context, from p2p.Peer, data []byte) error {
+				if from.EthAddress != client.Peer().EthAddress {
+					return errors.New("unexpected sender")
+				}
+				received <- string(data)
+				return nil
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.AddTopics(p2p.TopicDesc{Name: "test", Validate: validate}); err != nil {
+			t.Fatal(err)
+		}
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Connect(context.Background(), svAddr); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := client.Publish(context.Background(), "test", []byte("invalid")); err == nil {
+			t.Fatal("expected invalid message to be rejected")
+		}
+
+		// The message is published until the subscription of the service
+		// has propagated to the client.
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		timeout := time.After(10 * time.Second)
+		for {
+			if err := client.Publish(context.Background(), "test", []byte("valid")); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case msg := <-received:
+				if msg != "valid" {
+					t.Fatalf("expected valid message, got %s", msg)
+				}
+				return
+			case <-ticker.C:
+			case <-timeout:
+				t.Fatal("timed out waiting for message")
+			}
+		}
+	})
+
+	t.Run("pubsub trace context", func(t *testing.T) {
+		if _, err := tracing.New(tracing.Options{}); err != nil {
+			t.Fatal(err)
+		}
+
+		svc := newTestService(t, func(o *libp2p.Options) { o.PubSub = true })
+		client := newTestService(t, func(o *libp2p.Options) { o.PubSub = true })
+
+		t.Cleanup(func() {
+			if err := errors.Join(svc.Close(), client.Close()); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		traceIDs := make(chan trace.TraceID, 10)
+		err := svc.AddTopics(p2p.TopicDesc{
+			Name: "test",
+			Handler: func(ctx context.Context, _ p2p.Peer, _ []byte) error {
+				traceIDs <- trace.SpanContextFromContext(ctx).TraceID()
+				return nil
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.AddTopics(p2p.TopicDesc{Name: "test"}); err != nil {
+			t.Fatal(err)
+		}
+
+		svAddr, err := svc.Addrs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Connect(context.Background(), svAddr); err != nil {
+			t.Fatal(err)
+		}
+
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1, 2, 3},
+			SpanID:     trace.SpanID{4, 5, 6},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+		// The message is published until the subscription of the service
+		// has propagated to the client.
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		timeout := time.After(10 * time.Second)
+		for {
+			if err := client.Publish(ctx, "test", []byte("traced")); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case traceID := <-traceIDs:
+				if traceID != sc.TraceID() {
+					t.Fatalf("expected trace ID %s, got %s", sc.TraceID(), traceID)
+				}
+				return
+			case <-ticker.C:
+			case <-timeout:
+				t.Fatal("timed out waiting for message")
+			}
+		}
+	})
+
+	t.Run("pubsub rate limit", func(t *testing.T) {
+		svc := newTestService(t, func(o *libp2p.Options) { o.PubSub = true })
+		client := newTestService(t, func(o *libp2p.Options) { o.PubSub = true })
+
+		t.Cleanup(func() {
+			if err := errors.Join(svc.Close(), client.Close()); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		validate := func(context.Context, p2p.Peer, []byte) error { return nil }
+		received := make(chan string, 10)
+		err := svc.AddTopics(p2p.TopicDesc{
+			Name:     "test",
+			Validate: validate,
+			Handler: func(_ context.Context, _ p2p.Peer, data []byte) error {
+				received <- string(data)
+				return nil
+			},
+			RateLimits: map[p2p.PeerType]p2p.RateLimit{
+				p2p.PeerTypeProvider: {Rate: 0.001, Burst: 1},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.AddTopics(p2p.TopicDesc{Name: "test", Validate: validate}); err != nil {
+			t.Fatal(err)
+		}
+
+		svAddr, err := svc.Addrs()
 		if err != nil {
 			t.Fatal(err)
 		}
+		if _, err := client.Connect(context.Background(), svAddr); err != nil {
+			t.Fatal(err)
+		}
+
+		// The message is published until the subscription of the service
+		// has propagated to the client, the first one takes the only token.
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		timeout := time.After(10 * time.Second)
+	loop:
+		for {
+			if err := client.Publish(context.Background(), "test", []byte("msg")); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case <-received:
+				break loop
+			case <-ticker.C:
+			case <-timeout:
+				t.Fatal("timed out waiting for message")
+			}
+		}
+
+		for i := 0; i < 5; i++ {
+			if err := client.Publish(context.Background(), "test", []byte("msg")); err != nil {
+				t.Fatal(err)
+			}
+		}
+		select {
+		case msg := <-received:
+			t.Fatalf("expected messages over the limit to be dropped, got %s", msg)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+
+	t.Run("update bootnodes", func(t *testing.T) {
+		bootnode := newTestService(t)
+		svc := newTestService(t)
+
+		t.Cleanup(func() {
+			if err := errors.Join(bootnode.Close(), svc.Close()); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		if err := svc.SetBootnodes([]string{"invalid"}); err == nil {
+			t.Fatal("expected invalid bootnode to be rejected")
+		}
+
+		unreachable := "/ip4/127.0.0.1/tcp/1/p2p/" + svc.HostID().String()
+		if err := svc.SetBootnodes([]string{bootnode.AddrString(), unreachable}); err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Now()
+		for {
+			if time.Since(start) > 10*time.Second {
+				t.Fatalf("timed out waiting for bootnode status, got %+v", svc.Bootnodes())
+			}
+			status := svc.Bootnodes()
+			if len(status) != 2 {
+				t.Fatalf("expected 2 bootnodes, got %d", len(status))
+			}
+			if status[0].Connected && status[1].Failures > 0 {
+				if status[0].PeerIDs[0] != bootnode.HostID().String() {
+					t.Fatalf("unexpected peer IDs %v", status[0].PeerIDs)
+				}
+				if status[1].LastError == "" || !status[1].NextAttempt.After(status[1].LastAttempt) {
+					t.Fatalf("unexpected status %+v", status[1])
+				}
+				break
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
 	})
 
 	t.Run("add protocol and connect", func(t *testing.T) {
@@ -148,6 +1450,10 @@ func TestP2PService(t *testing.T) {
 			)
 		}
 
+		if p.Capabilities == nil || !p.Capabilities.SupportsProtocol(stream.Name, stream.Version) {
+			t.Fatalf("expected peer to support protocol %s/%s", stream.Name, stream.Version)
+		}
+
 		str, err := client.NewStream(context.Background(), p, nil, stream)
 		if err != nil {
 			t.Fatal(err)
@@ -170,11 +1476,14 @@ func TestP2PService(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		var svcAddr peer.AddrInfo
-		err = svcAddr.UnmarshalJSON(svcInfo)
+		// The record is signed by the ethereum key of the service.
+		svcAddr, err := peerrecord.Verify(svcInfo, time.Now())
 		if err != nil {
 			t.Fatal(err)
 		}
+		if svcInfo.EthAddress != svc.Peer().EthAddress {
+			t.Fatalf("expected address %s, got %s", svc.Peer().EthAddress, svcInfo.EthAddress)
+		}
 
 		if svcAddr.ID != svc.HostID() {
 			t.Fatalf("expected host id %s, got %s", svc.HostID(), svcAddr.ID)
@@ -185,8 +1494,7 @@ func TestP2PService(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		var clientAddr peer.AddrInfo
-		err = clientAddr.UnmarshalJSON(clientInfo)
+		clientAddr, err := peerrecord.Verify(clientInfo, time.Now())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -240,6 +1548,8 @@ func TestBootstrap(t *testing.T) {
 		PeerType:   p2p.PeerTypeProvider,
 		Register:   &testRegistry{},
 		Logger:     newTestLogger(t, os.Stdout),
+		ChainID:    big.NewInt(17864),
+		NetworkID:  1,
 	}
 
 	privKey, err := crypto.GenerateKey()
@@ -257,6 +1567,7 @@ func TestBootstrap(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	bootnode.Start()
 
 	notifier := &testNotifier{}
 	bootnode.SetNotifier(notifier)
@@ -277,6 +1588,7 @@ func TestBootstrap(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	p1.Start()
 
 	start := time.Now()
 	for {
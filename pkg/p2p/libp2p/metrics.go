@@ -7,9 +7,19 @@ type metrics struct {
 	RejectedConnectionCount      prometheus.Counter
 	FailedIncomingHandshakeCount prometheus.Counter
 	FailedOutgoingHandshakeCount prometheus.Counter
+	DroppedStreamsCount          *prometheus.CounterVec
+	ExpiredStreamsCount          *prometheus.CounterVec
+	Reachability                 *prometheus.GaugeVec
+	PublishedMessagesCount       *prometheus.CounterVec
+	ReceivedMessagesCount        *prometheus.CounterVec
+	RejectedMessagesCount        *prometheus.CounterVec
+	DroppedMessagesCount         *prometheus.CounterVec
+	StreamReadBytesCount         *prometheus.CounterVec
+	StreamWrittenBytesCount      *prometheus.CounterVec
 }
 
-func newMetrics(registry prometheus.Registerer, namespace string) *metrics {
+// newMetrics creates the metrics and registers them if registry is set.
+func newMetrics(registry *prometheus.Registry, namespace string) *metrics {
 	subsystem := "libp2p"
 
 	m := &metrics{
@@ -37,6 +47,64 @@ func newMetrics(registry prometheus.Registerer, namespace string) *metrics {
 			Name:      "failed_outgoing_handshake_count",
 			Help:      "Number of failed outgoing handshake count.",
 		}),
+		DroppedStreamsCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "dropped_streams_count",
+			Help:      "Number of inbound streams dropped because of the rate limit.",
+		}, []string{"protocol"}),
+		ExpiredStreamsCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "expired_streams_count",
+			Help:      "Number of inbound streams dropped because the deadline of the peer passed.",
+		}, []string{"protocol"}),
+		Reachability: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "reachability",
+			Help:      "Set to 1 for the reachability of the node detected by AutoNAT.",
+		}, []string{"reachability"}),
+		PublishedMessagesCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "published_messages_count",
+			Help:      "Number of messages published to pubsub topics.",
+		}, []string{"topic"}),
+		ReceivedMessagesCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "received_messages_count",
+			Help:      "Number of valid messages received on pubsub topics.",
+		}, []string{"topic"}),
+		RejectedMessagesCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "rejected_messages_count",
+			Help:      "Number of pubsub messages which failed the validation.",
+		}, []string{"topic"}),
+		DroppedMessagesCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "dropped_messages_count",
+			Help:      "Number of pubsub messages dropped because of the rate limit.",
+		}, []string{"topic"}),
+		StreamReadBytesCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "stream_read_bytes_count",
+			Help:      "Number of bytes read from protocol streams, after compression.",
+		}, []string{"protocol"}),
+		StreamWrittenBytesCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "stream_written_bytes_count",
+			Help:      "Number of bytes written to protocol streams, after compression.",
+		}, []string{"protocol"}),
+	}
+
+	if registry == nil {
+		return m
 	}
 
 	registry.MustRegister(
@@ -44,6 +112,15 @@ func newMetrics(registry prometheus.Registerer, namespace string) *metrics {
 		m.RejectedConnectionCount,
 		m.FailedIncomingHandshakeCount,
 		m.FailedOutgoingHandshakeCount,
+		m.DroppedStreamsCount,
+		m.ExpiredStreamsCount,
+		m.Reachability,
+		m.PublishedMessagesCount,
+		m.ReceivedMessagesCount,
+		m.RejectedMessagesCount,
+		m.DroppedMessagesCount,
+		m.StreamReadBytesCount,
+		m.StreamWrittenBytesCount,
 	)
 
 	return m
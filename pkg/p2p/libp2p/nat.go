@@ -0,0 +1,82 @@
+package libp2p
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// natOptions returns the libp2p options for circuit relay v2 and hole
+// punching. AutoNAT detects the reachability of the node, relays are only
+// used while it is private.
+func natOptions(opts *Options) ([]libp2p.Option, error) {
+	res := []libp2p.Option{libp2p.EnableRelay()}
+
+	if opts.RelayService {
+		res = append(res, libp2p.EnableRelayService())
+	}
+	if len(opts.StaticRelays) > 0 {
+		addrs, err := parseMultiaddrs(opts.StaticRelays)
+		if err != nil {
+			return nil, err
+		}
+		relays, err := peer.AddrInfosFromP2pAddrs(addrs...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid static relay: %w", err)
+		}
+		res = append(res, libp2p.EnableAutoRelayWithStaticRelays(relays))
+	}
+	if opts.HolePunching {
+		res = append(res, libp2p.EnableHolePunching())
+	}
+	return res, nil
+}
+
+// Reachability returns the reachability of the node as detected by AutoNAT.
+func (s *Service) Reachability() network.Reachability {
+	return network.Reachability(s.reachability.Load())
+}
+
+// watchReachability records the reachability changes detected by AutoNAT
+// until the service is closed.
+func (s *Service) watchReachability() {
+	sub, err := s.host.EventBus().Subscribe(new(event.EvtLocalReachabilityChanged))
+	if err != nil {
+		s.logger.Error("failed to subscribe to reachability changes", "err", err)
+		return
+	}
+	defer sub.Close()
+
+	s.setReachability(network.ReachabilityUnknown)
+	for {
+		select {
+		case <-s.baseCtx.Done():
+			return
+		case ev, ok := <-sub.Out():
+			if !ok {
+				return
+			}
+			reachability := ev.(event.EvtLocalReachabilityChanged).Reachability
+			s.setReachability(reachability)
+			s.logger.Info("reachability changed", "reachability", reachability, "addrs", s.host.Addrs())
+		}
+	}
+}
+
+func (s *Service) setReachability(r network.Reachability) {
+	s.reachability.Store(int32(r))
+	for _, state := range []network.Reachability{
+		network.ReachabilityUnknown,
+		network.ReachabilityPublic,
+		network.ReachabilityPrivate,
+	} {
+		value := 0.0
+		if state == r {
+			value = 1
+		}
+		s.metrics.Reachability.WithLabelValues(state.String()).Set(value)
+	}
+}
@@ -22,14 +22,17 @@ type peerRegistry struct {
 	// streams to a peer and cancel the contexts passed to the handlers when
 	// the stream is closed.
 	streams map[core.PeerID]map[network.Stream]context.CancelFunc
-	mu      sync.RWMutex
+	// directions maps peer IDs to the direction of the handshake which
+	// added the peer.
+	directions map[core.PeerID]p2p.Direction
+	mu         sync.RWMutex
 
 	disconnector disconnector
 	network.Notifiee
 }
 
 type disconnector interface {
-	disconnected(p2p.Peer)
+	disconnected(core.PeerID, p2p.Peer)
 }
 
 func newPeerRegistry() *peerRegistry {
@@ -38,6 +41,7 @@ func newPeerRegistry() *peerRegistry {
 		underlays:   make(map[common.Address]core.PeerID),
 		connections: make(map[core.PeerID]map[network.Conn]struct{}),
 		streams:     make(map[core.PeerID]map[network.Stream]context.CancelFunc),
+		directions:  make(map[core.PeerID]p2p.Direction),
 		Notifiee:    new(network.NoopNotifiee),
 	}
 }
@@ -46,19 +50,28 @@ func (r *peerRegistry) setDisconnector(d disconnector) {
 	r.disconnector = d
 }
 
+// Disconnected removes the peer once its last connection is closed. The
+// disconnector is called without holding the lock.
 func (r *peerRegistry) Disconnected(_ network.Network, c network.Conn) {
+	peerID := c.RemotePeer()
+	if peerInfo, removed := r.removeConn(c); removed {
+		r.disconnector.disconnected(peerID, peerInfo)
+	}
+}
+
+func (r *peerRegistry) removeConn(c network.Conn) (p2p.Peer, bool) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	peerID := c.RemotePeer()
 	if _, ok := r.connections[peerID]; !ok {
-		return
+		return p2p.Peer{}, false
 	}
 
 	delete(r.connections[peerID], c)
 	if len(r.connections[peerID]) > 0 {
 		// if there are still connections, don't remove the peer
-		return
+		return p2p.Peer{}, false
 	}
 
 	delete(r.connections, peerID)
@@ -69,26 +82,64 @@ func (r *peerRegistry) Disconnected(_ network.Network, c network.Conn) {
 		cancel()
 	}
 	delete(r.streams, peerID)
-	r.disconnector.disconnected(*peerInfo)
+	delete(r.directions, peerID)
+	return *peerInfo, true
 }
 
-func (r *peerRegistry) addPeer(c network.Conn, p *p2p.Peer) (exists bool) {
+// authorizeAndAddPeer adds the peer if the authorizer admits it. The peer is
+// authorized and added under the same lock, so that concurrent handshakes
+// can't exceed the limits of the authorizer. Peers which are known already
+// are not authorized again.
+func (r *peerRegistry) authorizeAndAddPeer(
+	c network.Conn,
+	p *p2p.Peer,
+	dir p2p.Direction,
+	authorizer Authorizer,
+) (exists bool, err error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, ok := r.connections[c.RemotePeer()]; !ok {
-		r.connections[c.RemotePeer()] = make(map[network.Conn]struct{})
+	if peerID, exists := r.underlays[p.EthAddress]; exists {
+		// Only track the connection if it belongs to the known peer ID, the
+		// same address might be used with another libp2p identity.
+		if peerID == c.RemotePeer() {
+			r.connections[peerID][c] = struct{}{}
+		}
+		return true, nil
 	}
-	r.connections[c.RemotePeer()][c] = struct{}{}
 
-	if _, exists := r.underlays[p.EthAddress]; exists {
-		return true
+	if authorizer != nil {
+		if err := authorizer.Authorize(*p, dir, r.count(p.Type, dir)); err != nil {
+			return false, err
+		}
 	}
 
+	r.connections[c.RemotePeer()] = map[network.Conn]struct{}{c: {}}
 	r.overlays[c.RemotePeer()] = p
 	r.underlays[p.EthAddress] = c.RemotePeer()
 	r.streams[c.RemotePeer()] = make(map[network.Stream]context.CancelFunc)
-	return false
+	r.directions[c.RemotePeer()] = dir
+	return false, nil
+}
+
+// updateRecord replaces the record of a peer which is connected with the same
+// identity if the record is newer. It returns false if the peer is not
+// connected.
+func (r *peerRegistry) updateRecord(peerID core.PeerID, p *p2p.Peer) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.overlays[peerID]
+	if !ok || prev.EthAddress != p.EthAddress {
+		return false
+	}
+	if p.Record != nil && (prev.Record == nil || p.Record.Timestamp > prev.Record.Timestamp) {
+		// The peer is replaced as it is used without holding the lock.
+		updated := *prev
+		updated.Record = p.Record
+		r.overlays[peerID] = &updated
+	}
+	return true
 }
 
 func (r *peerRegistry) removePeer(peer *p2p.Peer) (found bool, peerID core.PeerID) { //nolint:unused
@@ -103,6 +154,7 @@ func (r *peerRegistry) removePeer(peer *p2p.Peer) (found bool, peerID core.PeerI
 		cancel()
 	}
 	delete(r.streams, peerID)
+	delete(r.directions, peerID)
 	return
 }
 
@@ -122,7 +174,7 @@ func (r *peerRegistry) getPeerID(ethAddress common.Address) (core.PeerID, bool)
 	return peerID, ok
 }
 
-func (r *peerRegistry) getPeers() []*p2p.Peer { //nolint:unused
+func (r *peerRegistry) getPeers() []*p2p.Peer {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -133,6 +185,30 @@ func (r *peerRegistry) getPeers() []*p2p.Peer { //nolint:unused
 	return peers
 }
 
+// getPeerIDs returns the IDs of the connected peers.
+func (r *peerRegistry) getPeerIDs() []core.PeerID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]core.PeerID, 0, len(r.overlays))
+	for id := range r.overlays {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// count returns the number of connected peers of the given type which were
+// added by a handshake in the given direction. The caller holds the lock.
+func (r *peerRegistry) count(peerType p2p.PeerType, dir p2p.Direction) int {
+	n := 0
+	for id, peer := range r.overlays {
+		if peer.Type == peerType && r.directions[id] == dir {
+			n++
+		}
+	}
+	return n
+}
+
 func (r *peerRegistry) addStream(
 	peerID core.PeerID,
 	stream network.Stream,
@@ -0,0 +1,194 @@
+package libp2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	pubsubmsgv1 "github.com/primevprotocol/mev-commit/gen/go/pubsubmsg/v1"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrPubSubDisabled = errors.New("pubsub is disabled")
+
+// maxMessageHandlers is the number of messages of a topic which are handled
+// concurrently. The subscription drops the messages which arrive while all
+// handlers are busy once its buffer is full.
+const maxMessageHandlers = 32
+
+// topicName scopes the topic to the network so that nodes of different
+// networks never exchange messages.
+func (s *Service) topicName(name string) string {
+	return fmt.Sprintf("/mev-commit/%d/%s", s.networkID, name)
+}
+
+// topic returns the joined topic. Must be called with the topics lock held.
+func (s *Service) topic(name string) (*pubsub.Topic, error) {
+	if t, found := s.topics[name]; found {
+		return t, nil
+	}
+	t, err := s.pubsub.Join(s.topicName(name))
+	if err != nil {
+		return nil, err
+	}
+	s.topics[name] = t
+	return t, nil
+}
+
+// AddTopics joins the GossipSub topics. Topics with a handler are subscribed
+// to, the others are only published to.
+func (s *Service) AddTopics(topics ...p2p.TopicDesc) error {
+	if s.pubsub == nil {
+		return ErrPubSubDisabled
+	}
+
+	s.topicsMu.Lock()
+	defer s.topicsMu.Unlock()
+
+	for _, desc := range topics {
+		if desc.Validate != nil {
+			err := s.pubsub.RegisterTopicValidator(s.topicName(desc.Name), s.validator(desc))
+			if err != nil {
+				return fmt.Errorf("failed to register validator of topic %s: %w", desc.Name, err)
+			}
+		}
+
+		t, err := s.topic(desc.Name)
+		if err != nil {
+			return fmt.Errorf("failed to join topic %s: %w", desc.Name, err)
+		}
+		if desc.Handler == nil {
+			continue
+		}
+
+		sub, err := t.Subscribe()
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to topic %s: %w", desc.Name, err)
+		}
+		go s.handleMessages(sub, desc)
+	}
+	return nil
+}
+
+// Publish broadcasts the message to the peers subscribed to the topic. The
+// trace context of ctx is published with the message, so that the handlers
+// of the peers continue the trace.
+func (s *Service) Publish(ctx context.Context, topic string, data []byte) error {
+	if s.pubsub == nil {
+		return ErrPubSubDisabled
+	}
+
+	msg := &pubsubmsgv1.PubSubMsg{Header: make(p2p.Header), Data: data}
+	tracing.Inject(ctx, msg.Header)
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.topicsMu.Lock()
+	t, err := s.topic(topic)
+	s.topicsMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := t.Publish(ctx, data); err != nil {
+		return err
+	}
+	s.metrics.PublishedMessagesCount.WithLabelValues(topic).Inc()
+	return nil
+}
+
+// unwrapMessage returns the published message with its header.
+func unwrapMessage(data []byte) (*pubsubmsgv1.PubSubMsg, error) {
+	msg := new(pubsubmsgv1.PubSubMsg)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// sender returns the peer which forwarded a message. Messages are only
+// accepted from peers which completed the handshake.
+func (s *Service) sender(from peer.ID) (p2p.Peer, bool) {
+	if from == s.host.ID() {
+		return p2p.Peer{EthAddress: s.ethAddress, Type: s.peerType}, true
+	}
+	p, found := s.peers.getPeer(from)
+	if !found {
+		return p2p.Peer{}, false
+	}
+	return *p, true
+}
+
+func (s *Service) validator(desc p2p.TopicDesc) pubsub.ValidatorEx {
+	return func(ctx context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		p, found := s.sender(from)
+		if !found {
+			return pubsub.ValidationIgnore
+		}
+		// Forwarded messages are limited like the inbound streams, so that
+		// a peer can't flood the node with valid messages.
+		if from != s.host.ID() && !s.limiter.allowMessage(p, s.topicName(desc.Name), desc) {
+			s.logger.Warn("message rate limit exceeded", "topic", desc.Name, "peer", p)
+			s.metrics.DroppedMessagesCount.WithLabelValues(desc.Name).Inc()
+			return pubsub.ValidationIgnore
+		}
+		m, err := unwrapMessage(msg.Data)
+		if err == nil {
+			err = desc.Validate(ctx, p, m.Data)
+		}
+		if err != nil {
+			s.logger.Debug("rejected message", "topic", desc.Name, "peer", p, "err", err)
+			s.metrics.RejectedMessagesCount.WithLabelValues(desc.Name).Inc()
+			return pubsub.ValidationReject
+		}
+		return pubsub.ValidationAccept
+	}
+}
+
+func (s *Service) handleMessages(sub *pubsub.Subscription, desc p2p.TopicDesc) {
+	defer sub.Cancel()
+
+	sem := make(chan struct{}, maxMessageHandlers)
+	for {
+		msg, err := sub.Next(s.baseCtx)
+		if err != nil {
+			return
+		}
+		// Messages published by the node itself are delivered as well.
+		if msg.ReceivedFrom == s.host.ID() {
+			continue
+		}
+		p, found := s.sender(msg.ReceivedFrom)
+		if !found {
+			continue
+		}
+		m, err := unwrapMessage(msg.Data)
+		if err != nil {
+			s.logger.Debug("malformed message", "topic", desc.Name, "peer", p, "err", err)
+			continue
+		}
+		s.metrics.ReceivedMessagesCount.WithLabelValues(desc.Name).Inc()
+
+		select {
+		case sem <- struct{}{}:
+		case <-s.baseCtx.Done():
+			return
+		}
+		go func() {
+			defer func() { <-sem }()
+
+			ctx, span := startMessageSpan(s.baseCtx, p, desc, m.Header)
+			err := desc.Handler(ctx, p, m.Data)
+			tracing.EndSpan(span, err)
+			if err != nil {
+				s.logger.Error("failed to handle message", "topic", desc.Name, "peer", p, "err", err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,106 @@
+package libp2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"golang.org/x/time/rate"
+)
+
+// DefaultStreamRateLimit applies to protocols, topics and peer types without
+// a configured limit.
+var DefaultStreamRateLimit = p2p.RateLimit{Rate: 10, Burst: 20}
+
+// streamLimiterTTL is how long the buckets of a disconnected peer are kept, so
+// that a peer can't refill them by reconnecting.
+const streamLimiterTTL = 10 * time.Minute
+
+// peerLimiters are the buckets of a peer per protocol and topic.
+type peerLimiters struct {
+	protocols map[string]*rate.Limiter
+	// disconnected is the time the peer disconnected, it is zero while the
+	// peer is connected.
+	disconnected time.Time
+}
+
+// streamLimiter keeps a token bucket per peer and protocol for the inbound
+// streams, and per peer and topic for the forwarded messages.
+type streamLimiter struct {
+	mu        sync.Mutex
+	overrides map[p2p.PeerType]p2p.RateLimit
+	limiters  map[common.Address]*peerLimiters
+}
+
+func newStreamLimiter(overrides map[p2p.PeerType]p2p.RateLimit) *streamLimiter {
+	return &streamLimiter{
+		overrides: overrides,
+		limiters:  make(map[common.Address]*peerLimiters),
+	}
+}
+
+// limit returns the limit configured by the operator for the peer type, the
+// limit of the protocol or topic otherwise.
+func (l *streamLimiter) limit(peerType p2p.PeerType, limits map[p2p.PeerType]p2p.RateLimit) p2p.RateLimit {
+	if limit, ok := l.overrides[peerType]; ok {
+		return limit
+	}
+	if limit, ok := limits[peerType]; ok {
+		return limit
+	}
+	return DefaultStreamRateLimit
+}
+
+// allow returns false if the peer exceeded the limit for the protocol.
+func (l *streamLimiter) allow(peer p2p.Peer, desc p2p.StreamDesc) bool {
+	return l.take(peer, desc.Name, desc.RateLimits)
+}
+
+// allowMessage returns false if the peer exceeded the limit for the topic.
+// The bucket is keyed by the full topic name so that it never shares the
+// bucket of a protocol.
+func (l *streamLimiter) allowMessage(peer p2p.Peer, topic string, desc p2p.TopicDesc) bool {
+	return l.take(peer, topic, desc.RateLimits)
+}
+
+// take takes a token from the bucket of the peer for name.
+func (l *streamLimiter) take(peer p2p.Peer, name string, limits map[p2p.PeerType]p2p.RateLimit) bool {
+	limit := l.limit(peer.Type, limits)
+	if limit.Rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	pl, ok := l.limiters[peer.EthAddress]
+	if !ok {
+		pl = &peerLimiters{protocols: make(map[string]*rate.Limiter)}
+		l.limiters[peer.EthAddress] = pl
+	}
+	pl.disconnected = time.Time{}
+	limiter, ok := pl.protocols[name]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(limit.Rate), max(limit.Burst, 1))
+		pl.protocols[name] = limiter
+	}
+	return limiter.Allow()
+}
+
+// disconnected keeps the buckets of a disconnected peer for streamLimiterTTL
+// and drops the buckets of peers which were disconnected for longer.
+func (l *streamLimiter) disconnected(ethAddress common.Address) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if pl, ok := l.limiters[ethAddress]; ok {
+		pl.disconnected = now
+	}
+	for addr, pl := range l.limiters {
+		if !pl.disconnected.IsZero() && now.Sub(pl.disconnected) > streamLimiterTTL {
+			delete(l.limiters, addr)
+		}
+	}
+}
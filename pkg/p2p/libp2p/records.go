@@ -0,0 +1,66 @@
+package libp2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p/internal/handshake"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/peerrecord"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordRefreshInterval is the interval at which the records are exchanged
+// again with the connected peers, so that the records which they announce
+// don't expire.
+const recordRefreshInterval = peerrecord.MaxAge / 4
+
+// refreshRecords repeats the handshake with the connected peers until the
+// service is closed.
+func (s *Service) refreshRecords() {
+	ticker := time.NewTicker(recordRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.baseCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, peerID := range s.peers.getPeerIDs() {
+			if err := s.refreshRecord(peerID); err != nil {
+				s.logger.Debug("failed to refresh peer record", "peer", peerID, "err", err)
+			}
+		}
+	}
+}
+
+// refreshRecord repeats the handshake on the connection to a peer. Both peers
+// replace the record of the other one, the peer rejects the handshake with
+// AlreadyExists once it has done so.
+func (s *Service) refreshRecord(peerID peer.ID) error {
+	ctx, cancel := context.WithTimeout(s.baseCtx, handshake.Timeout)
+	defer cancel()
+
+	streamCtx := network.WithUseTransient(ctx, handshake.ProtocolName)
+	streamlibp2p, err := s.host.NewStream(streamCtx, peerID, handshake.ProtocolID())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = streamlibp2p.Reset() }()
+
+	_, err = s.hsSvc.Handshake(ctx, peerID, newStream(streamlibp2p, nil, nil), func(p *p2p.Peer) error {
+		if !s.peers.updateRecord(peerID, p) {
+			return p2p.ErrPeerNotFound
+		}
+		return nil
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
@@ -2,12 +2,14 @@ package libp2p
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/libp2p/go-msgio"
 	streammsgv1 "github.com/primevprotocol/mev-commit/gen/go/streammsg/v1"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -17,12 +19,38 @@ type networkStream interface {
 	Reset() error
 }
 
+// DefaultMaxMessageSize is the maximum message size of protocols without a
+// limit of their own.
+const DefaultMaxMessageSize = 8 << 20
+
+// envelopeOverhead is the size of the tag and length of the data in a
+// StreamMsg.
+const envelopeOverhead = 16
+
 func newStream(libp2pstream networkStream, hdrs, respHdrs p2p.Header) p2p.Stream {
+	return newProtocolStream(libp2pstream, hdrs, respHdrs, identityCodec{}, DefaultMaxMessageSize)
+}
+
+// newProtocolStream returns a stream which encodes the messages with the
+// codec. Frames which can't hold a message of at most maxSize bytes are
+// rejected before they are read.
+func newProtocolStream(
+	libp2pstream networkStream,
+	hdrs, respHdrs p2p.Header,
+	c codec,
+	maxSize int,
+) *stream {
+	frameSize := c.maxEncodedLen(maxSize) + envelopeOverhead
 	return &stream{
 		networkStream: libp2pstream,
-		rw:            msgio.NewReadWriter(libp2pstream),
-		hdrs:          hdrs,
-		respHdrs:      respHdrs,
+		rw: msgio.Combine(
+			msgio.NewWriter(libp2pstream),
+			msgio.NewReaderSize(libp2pstream, frameSize),
+		),
+		hdrs:     hdrs,
+		respHdrs: respHdrs,
+		codec:    c,
+		maxSize:  maxSize,
 	}
 }
 
@@ -31,6 +59,8 @@ type stream struct {
 	rw       msgio.ReadWriter
 	hdrs     p2p.Header
 	respHdrs p2p.Header
+	codec    codec
+	maxSize  int
 }
 
 type result struct {
@@ -49,6 +79,9 @@ func (s *stream) ReadMsg(ctx context.Context, m proto.Message) error {
 	case <-ctx.Done():
 		return ctx.Err()
 	case res := <-ch:
+		if errors.Is(res.err, msgio.ErrMsgTooLarge) {
+			return fmt.Errorf("failed to read message: %w", p2p.ErrMessageTooLarge)
+		}
 		if res.err != nil {
 			return fmt.Errorf("failed to read message: %w", res.err)
 		}
@@ -67,7 +100,12 @@ func (s *stream) ReadMsg(ctx context.Context, m proto.Message) error {
 			return fmt.Errorf("message has no data")
 		}
 
-		return proto.Unmarshal(sMsg.GetData(), m)
+		data, err := s.codec.decode(sMsg.GetData(), s.maxSize)
+		if err != nil {
+			return fmt.Errorf("failed to decode message: %w", err)
+		}
+
+		return proto.Unmarshal(data, m)
 	}
 }
 
@@ -76,10 +114,13 @@ func (s *stream) WriteMsg(ctx context.Context, m proto.Message) error {
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
+	if len(msg) > s.maxSize {
+		return fmt.Errorf("%w: %d bytes", p2p.ErrMessageTooLarge, len(msg))
+	}
 
 	sMsg := &streammsgv1.StreamMsg{
 		Body: &streammsgv1.StreamMsg_Data{
-			Data: msg,
+			Data: s.codec.encode(msg),
 		},
 	}
 
@@ -102,6 +143,25 @@ func (s *stream) WriteMsg(ctx context.Context, m proto.Message) error {
 	}
 }
 
+// meteredStream counts the bytes read from and written to a stream.
+type meteredStream struct {
+	networkStream
+	read    prometheus.Counter
+	written prometheus.Counter
+}
+
+func (s *meteredStream) Read(b []byte) (int, error) {
+	n, err := s.networkStream.Read(b)
+	s.read.Add(float64(n))
+	return n, err
+}
+
+func (s *meteredStream) Write(b []byte) (int, error) {
+	n, err := s.networkStream.Write(b)
+	s.written.Add(float64(n))
+	return n, err
+}
+
 type metadataStream struct {
 	networkStream
 	rw msgio.ReadWriter
@@ -3,11 +3,13 @@ package libp2p_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
 	streammsgv1 "github.com/primevprotocol/mev-commit/gen/go/streammsg/v1"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
 	"github.com/primevprotocol/mev-commit/pkg/p2p/libp2p"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
@@ -239,3 +241,45 @@ func TestMetadataStream_WriteError(t *testing.T) {
 	assert.Equal(t, codes.Internal, status.Convert(err).Code())
 	assert.Equal(t, "test error", status.Convert(err).Message())
 }
+
+func TestStream_Encodings(t *testing.T) {
+	for _, encoding := range []string{
+		p2p.EncodingIdentity,
+		p2p.EncodingSnappy,
+		p2p.EncodingZstd,
+	} {
+		t.Run(encoding, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			msg := &streammsgv1.StreamMsg{
+				Body: &streammsgv1.StreamMsg_Data{
+					Data: bytes.Repeat([]byte("test message"), 100),
+				},
+			}
+
+			bs := newBufferStream()
+			s := libp2p.NewEncodedStream(bs, encoding, 2048)
+			assert.NoError(t, s.WriteMsg(ctx, msg))
+			assert.NoError(t, s.WriteMsg(ctx, &streammsgv1.StreamMsg{}))
+
+			result := &streammsgv1.StreamMsg{}
+			assert.NoError(t, s.ReadMsg(ctx, result))
+			assert.Equal(t, msg.GetData(), result.GetData())
+			assert.NoError(t, s.ReadMsg(ctx, result))
+			assert.Nil(t, result.GetBody())
+
+			// Messages larger than the limit are neither written nor read.
+			small := libp2p.NewEncodedStream(bs, encoding, 512)
+			err := small.WriteMsg(ctx, msg)
+			if !errors.Is(err, p2p.ErrMessageTooLarge) {
+				t.Fatalf("expected error %v on write, got %v", p2p.ErrMessageTooLarge, err)
+			}
+			assert.NoError(t, s.WriteMsg(ctx, msg))
+			err = small.ReadMsg(ctx, result)
+			if !errors.Is(err, p2p.ErrMessageTooLarge) {
+				t.Fatalf("expected error %v on read, got %v", p2p.ErrMessageTooLarge, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package libp2p
+
+import (
+	"context"
+
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/primevprotocol/mev-commit/pkg/p2p/libp2p")
+
+// startHandlerSpan starts the span of an inbound stream as a child of the
+// span of the peer which opened it.
+func startHandlerSpan(
+	ctx context.Context,
+	p p2p.Peer,
+	desc p2p.StreamDesc,
+	hdr p2p.Header,
+) (context.Context, trace.Span) {
+	return tracer.Start(
+		tracing.Extract(ctx, hdr),
+		desc.Name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("protocol.version", desc.Version),
+			attribute.String("peer.address", p.EthAddress.Hex()),
+			attribute.String("peer.type", p.Type.String()),
+		),
+	)
+}
+
+// startMessageSpan starts the span of a pubsub message as a child of the
+// span of the peer which published it.
+func startMessageSpan(
+	ctx context.Context,
+	p p2p.Peer,
+	desc p2p.TopicDesc,
+	hdr p2p.Header,
+) (context.Context, trace.Span) {
+	return tracer.Start(
+		tracing.Extract(ctx, hdr),
+		desc.Name,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("peer.address", p.EthAddress.Hex()),
+			attribute.String("peer.type", p.Type.String()),
+		),
+	)
+}
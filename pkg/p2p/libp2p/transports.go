@@ -0,0 +1,88 @@
+package libp2p
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+)
+
+// listenAddrs returns the configured listen multiaddrs. Without any, the node
+// listens on ListenAddr and ListenPort over TCP.
+func listenAddrs(opts *Options) ([]ma.Multiaddr, error) {
+	if len(opts.ListenAddrs) == 0 {
+		addr, err := hostPortMultiaddr(net.JoinHostPort(opts.ListenAddr, fmt.Sprint(opts.ListenPort)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid listen address: %w", err)
+		}
+		return []ma.Multiaddr{addr}, nil
+	}
+	return parseMultiaddrs(opts.ListenAddrs)
+}
+
+// externalAddrs returns the addresses under which the node is reachable in
+// addition to its listen addresses.
+func externalAddrs(opts *Options) ([]ma.Multiaddr, error) {
+	addrs, err := parseMultiaddrs(opts.ExternalAddrs)
+	if err != nil {
+		return nil, err
+	}
+	if opts.NatAddr != "" {
+		addr, err := hostPortMultiaddr(opts.NatAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NAT address: %w", err)
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+func parseMultiaddrs(addrs []string) ([]ma.Multiaddr, error) {
+	res := make([]ma.Multiaddr, 0, len(addrs))
+	for _, a := range addrs {
+		addr, err := ma.NewMultiaddr(a)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multiaddr %q: %w", a, err)
+		}
+		res = append(res, addr)
+	}
+	return res, nil
+}
+
+// hostPortMultiaddr converts a host:port pair to a TCP multiaddr. The host may
+// be an IPv4 or IPv6 address or a DNS name.
+func hostPortMultiaddr(hostPort string) (ma.Multiaddr, error) {
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, err
+	}
+
+	proto := "dns"
+	if ip := net.ParseIP(host); ip != nil {
+		proto = "ip6"
+		if ip.To4() != nil {
+			proto = "ip4"
+		}
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/%s/%s/tcp/%s", proto, host, port))
+}
+
+// learnAddrs adds the dialable addresses from the signed peer record to the
+// peerstore so that the peer can be dialed again over all its transports and
+// external addresses, not only the one of the current connection.
+func (s *Service) learnAddrs(peerID peer.ID, p p2p.Peer) {
+	if p.Record == nil {
+		return
+	}
+
+	var info peer.AddrInfo
+	if err := info.UnmarshalJSON(p.Record.Underlay); err != nil {
+		s.logger.Debug("failed to parse peer record", "peer", p, "err", err)
+		return
+	}
+	addrs := s.addrFilter.filterDialable(info.Addrs)
+	s.host.Peerstore().AddAddrs(peerID, addrs, peerstore.PermanentAddrTTL)
+}
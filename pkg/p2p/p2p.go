@@ -21,6 +21,9 @@ const (
 	PeerTypeProvider
 	// PeerTypeBidder is a bidder node
 	PeerTypeBidder
+	// PeerTypeObserver is a read-only node which follows the network
+	// without stake and can't submit bids.
+	PeerTypeObserver
 )
 
 func (pt PeerType) String() string {
@@ -31,6 +34,8 @@ func (pt PeerType) String() string {
 		return "provider"
 	case PeerTypeBidder:
 		return "bidder"
+	case PeerTypeObserver:
+		return "observer"
 	default:
 		return "unknown"
 	}
@@ -44,24 +49,63 @@ func FromString(str string) PeerType {
 		return PeerTypeProvider
 	case "bidder":
 		return PeerTypeBidder
+	case "observer":
+		return PeerTypeObserver
 	default:
 		return -1
 	}
 }
 
+// Direction is the direction of a connection to a peer.
+type Direction int
+
+const (
+	// DirectionInbound is a connection dialed by the peer.
+	DirectionInbound Direction = iota
+	// DirectionOutbound is a connection dialed by this node.
+	DirectionOutbound
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionInbound:
+		return "inbound"
+	case DirectionOutbound:
+		return "outbound"
+	default:
+		return "unknown"
+	}
+}
+
 var (
 	ErrPeerNotFound = errors.New("peer not found")
 	ErrNoAddresses  = errors.New("no addresses")
+	ErrNoPeerRecord = errors.New("no peer record")
+	// ErrMessageTooLarge is returned for stream messages which exceed the
+	// maximum message size of the protocol.
+	ErrMessageTooLarge = errors.New("message too large")
 )
 
 type Peer struct {
 	EthAddress common.Address
 	Type       PeerType
+	// Capabilities negotiated during the handshake. It is nil if the peer
+	// is not known through a handshake.
+	Capabilities *Capabilities
+	// Record is the peer record signed by the peer during the handshake. It
+	// is nil if the peer is not known through a handshake.
+	Record *PeerInfo
 }
 
+// PeerInfo is the record used to announce a peer to other peers. The
+// signature of the ethereum key of the peer over the address, the underlay
+// and the timestamp proves that the underlay belongs to the peer.
 type PeerInfo struct {
 	EthAddress common.Address
 	Underlay   []byte
+	// Timestamp is the unix time in seconds when the record was signed.
+	Timestamp int64
+	Signature []byte
 }
 
 // Stream is a bidirectional stream of messages between two peers per protocol.
@@ -94,16 +138,57 @@ type HandlerFunc func(ctx context.Context, peer Peer, stream Stream) error
 // HeaderFunc is a function that handles a header.
 type HeaderFunc func(ctx context.Context, peer Peer, hdr Header) Header
 
+// RateLimit is a token bucket limit for the streams a peer may open or the
+// messages it may forward. Rate is the number per second and Burst the number
+// which may arrive at once. A zero Rate disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
 // StreamDesc describes a stream handler.
 type StreamDesc struct {
 	Name    string
 	Version string
 	Handler HandlerFunc
 	Header  HeaderFunc
+	// RateLimits limit the inbound streams of the protocol per peer type.
+	// The limits configured by the operator take precedence.
+	RateLimits map[PeerType]RateLimit
+	// MaxMessageSize is the maximum size of the messages of the protocol in
+	// bytes before compression. Larger messages are rejected when they are
+	// written or read. The default limit of the service applies if it is
+	// zero.
+	MaxMessageSize int
+}
+
+// MessageHandler handles a message received on a pubsub topic. The peer is
+// the one which forwarded the message, not necessarily its author.
+type MessageHandler func(ctx context.Context, from Peer, data []byte) error
+
+// TopicDesc describes a pubsub topic.
+type TopicDesc struct {
+	Name string
+	// Validate is called for every message, including the ones published
+	// by the node itself, before it is handled or forwarded. Messages which
+	// fail the validation are dropped and not propagated.
+	Validate MessageHandler
+	// Handler is called for the valid messages. The node only publishes
+	// and forwards messages of the topic if it is not set.
+	Handler MessageHandler
+	// RateLimits limit the messages forwarded by a peer per peer type.
+	// Messages over the limit are dropped before the validation. The limits
+	// configured by the operator take precedence.
+	RateLimits map[PeerType]RateLimit
+}
+
+// PubSub broadcasts messages to the peers subscribed to a topic.
+type PubSub interface {
+	Publish(ctx context.Context, topic string, data []byte) error
 }
 
 type Addressbook interface {
-	GetPeerInfo(Peer) ([]byte, error)
+	GetPeerInfo(Peer) (PeerInfo, error)
 }
 
 type Streamer interface {
@@ -127,7 +212,10 @@ type Notifier interface {
 }
 
 type BlockedPeerInfo struct {
+	// Peer is the ethereum address of the blocked peer if it is known. Peers
+	// blocked during the handshake are only identified by their peer ID.
 	Peer     common.Address
+	PeerID   string
 	Reason   string
 	Duration string
 }
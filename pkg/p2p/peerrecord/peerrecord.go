@@ -0,0 +1,98 @@
+package peerrecord
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/primevprotocol/mev-commit/pkg/keysigner"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/signer"
+)
+
+const (
+	// signingDomain separates peer record signatures from any other data
+	// signed by the ethereum key.
+	signingDomain = "mev-commit/peer-record/1"
+
+	// MaxClockSkew is the maximum time a record may be signed in the future.
+	MaxClockSkew = 30 * time.Second
+	// MaxAge is the maximum age of a record, older records can't be
+	// replayed with outdated underlays. Nodes refresh their records at the
+	// connected peers before they expire.
+	MaxAge = 7 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid peer record signature")
+	ErrInvalidTimestamp = errors.New("peer record timestamp in the future")
+	ErrExpired          = errors.New("peer record expired")
+	ErrInvalidUnderlay  = errors.New("invalid peer record underlay")
+)
+
+func signingData(ethAddress common.Address, underlay []byte, timestamp int64) []byte {
+	var buf bytes.Buffer
+	// Length prefixes keep the encoding unambiguous.
+	writeField := func(field []byte) {
+		_ = binary.Write(&buf, binary.BigEndian, uint32(len(field)))
+		buf.Write(field)
+	}
+
+	writeField([]byte(signingDomain))
+	writeField(ethAddress.Bytes())
+	writeField(underlay)
+	_ = binary.Write(&buf, binary.BigEndian, timestamp)
+	return buf.Bytes()
+}
+
+// Sign returns the record of the node with the ethereum key of the key
+// signer.
+func Sign(ks keysigner.KeySigner, underlay []byte, timestamp int64) (p2p.PeerInfo, error) {
+	info := p2p.PeerInfo{
+		EthAddress: ks.GetAddress(),
+		Underlay:   underlay,
+		Timestamp:  timestamp,
+	}
+
+	hash := crypto.Keccak256Hash(signingData(info.EthAddress, underlay, timestamp))
+	sig, err := ks.SignHash(hash.Bytes())
+	if err != nil {
+		return p2p.PeerInfo{}, err
+	}
+	info.Signature = sig
+	return info, nil
+}
+
+// Verify checks that the record was signed by the ethereum key of the
+// announced peer and is not older than MaxAge, and returns the address info
+// of its underlay.
+func Verify(info p2p.PeerInfo, now time.Time) (peer.AddrInfo, error) {
+	age := now.Sub(time.Unix(info.Timestamp, 0))
+	if age < -MaxClockSkew {
+		return peer.AddrInfo{}, ErrInvalidTimestamp
+	}
+	if age > MaxAge {
+		return peer.AddrInfo{}, ErrExpired
+	}
+
+	verified, ethAddress, err := signer.New().Verify(
+		info.Signature,
+		signingData(info.EthAddress, info.Underlay, info.Timestamp),
+	)
+	if err != nil {
+		return peer.AddrInfo{}, errors.Join(err, ErrInvalidSignature)
+	}
+	if !verified || ethAddress != info.EthAddress {
+		return peer.AddrInfo{}, ErrInvalidSignature
+	}
+
+	var addrInfo peer.AddrInfo
+	if err := addrInfo.UnmarshalJSON(info.Underlay); err != nil {
+		return peer.AddrInfo{}, errors.Join(err, ErrInvalidUnderlay)
+	}
+	return addrInfo, nil
+}
@@ -0,0 +1,101 @@
+package peerrecord_test
+
+import (
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mockkeysigner "github.com/primevprotocol/mev-commit/pkg/keysigner/mock"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/peerrecord"
+)
+
+func TestPeerRecord(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := mockkeysigner.NewMockKeySigner(privKey, crypto.PubkeyToAddress(privKey.PublicKey))
+
+	_, pubKey, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerID, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	underlay, err := peer.AddrInfo{ID: peerID}.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	record, err := peerrecord.Sign(ks, underlay, now.Unix())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		addrInfo, err := peerrecord.Verify(record, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if addrInfo.ID != peerID {
+			t.Fatalf("expected peer ID %s, got %s", peerID, addrInfo.ID)
+		}
+	})
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*p2p.PeerInfo)
+		now    time.Time
+		err    error
+	}{
+		{
+			name:   "other address",
+			modify: func(r *p2p.PeerInfo) { r.EthAddress = common.HexToAddress("0x1") },
+			now:    now,
+			err:    peerrecord.ErrInvalidSignature,
+		},
+		{
+			name:   "other underlay",
+			modify: func(r *p2p.PeerInfo) { r.Underlay = []byte(`{"ID":"other"}`) },
+			now:    now,
+			err:    peerrecord.ErrInvalidSignature,
+		},
+		{
+			name:   "missing signature",
+			modify: func(r *p2p.PeerInfo) { r.Signature = nil },
+			now:    now,
+			err:    peerrecord.ErrInvalidSignature,
+		},
+		{
+			name:   "future timestamp",
+			modify: func(*p2p.PeerInfo) {},
+			now:    now.Add(-2 * peerrecord.MaxClockSkew),
+			err:    peerrecord.ErrInvalidTimestamp,
+		},
+		{
+			name:   "expired",
+			modify: func(*p2p.PeerInfo) {},
+			now:    now.Add(peerrecord.MaxAge + time.Minute),
+			err:    peerrecord.ErrExpired,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := record
+			tc.modify(&r)
+			if _, err := peerrecord.Verify(r, tc.now); !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/util"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	ErrDenied       = errors.New("peer is denied by policy")
+	ErrNotAllowed   = errors.New("peer is not allowed by policy")
+	ErrTooManyPeers = errors.New("maximum number of peers reached")
+)
+
+// Rule is the policy for one peer type.
+type Rule struct {
+	// Allow lists the only addresses admitted. All addresses are admitted if
+	// it is empty.
+	Allow []string `yaml:"allow"`
+	// Deny lists addresses which are never admitted.
+	Deny []string `yaml:"deny"`
+	// MaxPeers is the maximum number of peers of the type connected in the
+	// direction of the rule. There is no limit if it is zero.
+	MaxPeers int `yaml:"max_peers"`
+}
+
+// Config is the YAML representation of the policy. The rules are keyed by
+// peer type.
+//
+//	inbound:
+//	  bidder:
+//	    deny:
+//	      - 0x...
+//	    max_peers: 100
+//	outbound:
+//	  provider:
+//	    allow:
+//	      - 0x...
+type Config struct {
+	Inbound  map[string]Rule `yaml:"inbound"`
+	Outbound map[string]Rule `yaml:"outbound"`
+}
+
+type rule struct {
+	allow    map[common.Address]struct{}
+	deny     map[common.Address]struct{}
+	maxPeers int
+}
+
+type rules map[p2p.PeerType]rule
+
+// Policy decides which peers verified in the handshake are admitted. It is
+// safe to reload the policy while it is used.
+type Policy struct {
+	path     string
+	logger   *slog.Logger
+	mu       sync.RWMutex
+	inbound  rules
+	outbound rules
+}
+
+// New loads the policy from the YAML file at path. If path is empty, all
+// peers are admitted.
+func New(path string, logger *slog.Logger) (*Policy, error) {
+	if path != "" {
+		var err error
+		if path, err = util.ResolveFilePath(path); err != nil {
+			return nil, err
+		}
+	}
+
+	p := &Policy{
+		path:   path,
+		logger: logger,
+	}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Reload reads the policy file again. The current policy is kept if the file
+// is invalid.
+func (p *Policy) Reload() error {
+	if p.path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	cfg := new(Config)
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return fmt.Errorf("failed to parse policy file: %w", err)
+	}
+
+	inbound, err := parseRules(cfg.Inbound)
+	if err != nil {
+		return fmt.Errorf("invalid inbound policy: %w", err)
+	}
+	outbound, err := parseRules(cfg.Outbound)
+	if err != nil {
+		return fmt.Errorf("invalid outbound policy: %w", err)
+	}
+
+	p.mu.Lock()
+	p.inbound = inbound
+	p.outbound = outbound
+	p.mu.Unlock()
+
+	p.logger.Info("peer policy loaded", "path", p.path)
+	return nil
+}
+
+func parseRules(cfg map[string]Rule) (rules, error) {
+	res := make(rules)
+	for name, r := range cfg {
+		peerType := p2p.FromString(name)
+		if peerType == -1 {
+			return nil, fmt.Errorf("unknown peer type %q", name)
+		}
+
+		allow, err := parseAddresses(r.Allow)
+		if err != nil {
+			return nil, err
+		}
+		deny, err := parseAddresses(r.Deny)
+		if err != nil {
+			return nil, err
+		}
+		if r.MaxPeers < 0 {
+			return nil, fmt.Errorf("invalid max_peers %d for %s", r.MaxPeers, name)
+		}
+
+		res[peerType] = rule{
+			allow:    allow,
+			deny:     deny,
+			maxPeers: r.MaxPeers,
+		}
+	}
+	return res, nil
+}
+
+func parseAddresses(addrs []string) (map[common.Address]struct{}, error) {
+	res := make(map[common.Address]struct{}, len(addrs))
+	for _, a := range addrs {
+		if !common.IsHexAddress(a) {
+			return nil, fmt.Errorf("invalid address %q", a)
+		}
+		res[common.HexToAddress(a)] = struct{}{}
+	}
+	return res, nil
+}
+
+// Authorize returns an error if the peer is not admitted. The connected
+// argument is the number of peers of the same type which are already
+// connected in the direction dir, the limits of both directions are
+// independent.
+func (p *Policy) Authorize(peer p2p.Peer, dir p2p.Direction, connected int) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	rs := p.inbound
+	if dir == p2p.DirectionOutbound {
+		rs = p.outbound
+	}
+
+	r, ok := rs[peer.Type]
+	if !ok {
+		return nil
+	}
+
+	if _, denied := r.deny[peer.EthAddress]; denied {
+		return ErrDenied
+	}
+
+	if len(r.allow) > 0 {
+		if _, allowed := r.allow[peer.EthAddress]; !allowed {
+			return ErrNotAllowed
+		}
+	}
+
+	if r.maxPeers > 0 && connected >= r.maxPeers {
+		return ErrTooManyPeers
+	}
+
+	return nil
+}
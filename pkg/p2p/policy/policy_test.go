@@ -0,0 +1,173 @@
+package policy_test
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/policy"
+)
+
+func newTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func writePolicy(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	t.Parallel()
+
+	allowed := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	denied := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	other := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	t.Run("no policy", func(t *testing.T) {
+		p, err := policy.New("", newTestLogger())
+		if err != nil {
+			t.Fatal(err)
+		}
+		peer := p2p.Peer{EthAddress: other, Type: p2p.PeerTypeBidder}
+		if err := p.Authorize(peer, p2p.DirectionInbound, 1000); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policy.yaml")
+		writePolicy(t, path, `
+inbound:
+  bidder:
+    deny:
+      - "0x2222222222222222222222222222222222222222"
+    max_peers: 2
+outbound:
+  provider:
+    allow:
+      - "0x1111111111111111111111111111111111111111"
+`)
+
+		p, err := policy.New(path, newTestLogger())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			name      string
+			peer      p2p.Peer
+			dir       p2p.Direction
+			connected int
+			err       error
+		}{
+			{
+				name: "denied bidder",
+				peer: p2p.Peer{EthAddress: denied, Type: p2p.PeerTypeBidder},
+				dir:  p2p.DirectionInbound,
+				err:  policy.ErrDenied,
+			},
+			{
+				name: "bidder",
+				peer: p2p.Peer{EthAddress: other, Type: p2p.PeerTypeBidder},
+				dir:  p2p.DirectionInbound,
+			},
+			{
+				name:      "too many bidders",
+				peer:      p2p.Peer{EthAddress: other, Type: p2p.PeerTypeBidder},
+				dir:       p2p.DirectionInbound,
+				connected: 2,
+				err:       policy.ErrTooManyPeers,
+			},
+			{
+				name: "outbound bidder",
+				peer: p2p.Peer{EthAddress: denied, Type: p2p.PeerTypeBidder},
+				dir:  p2p.DirectionOutbound,
+			},
+			{
+				name: "allowed provider",
+				peer: p2p.Peer{EthAddress: allowed, Type: p2p.PeerTypeProvider},
+				dir:  p2p.DirectionOutbound,
+			},
+			{
+				name: "provider not in allowlist",
+				peer: p2p.Peer{EthAddress: other, Type: p2p.PeerTypeProvider},
+				dir:  p2p.DirectionOutbound,
+				err:  policy.ErrNotAllowed,
+			},
+			{
+				name: "inbound provider",
+				peer: p2p.Peer{EthAddress: other, Type: p2p.PeerTypeProvider},
+				dir:  p2p.DirectionInbound,
+			},
+		}
+
+		for _, tc := range tests {
+			err := p.Authorize(tc.peer, tc.dir, tc.connected)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s: expected error %v, got %v", tc.name, tc.err, err)
+			}
+		}
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policy.yaml")
+		writePolicy(t, path, "")
+
+		p, err := policy.New(path, newTestLogger())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peer := p2p.Peer{EthAddress: denied, Type: p2p.PeerTypeProvider}
+		if err := p.Authorize(peer, p2p.DirectionInbound, 0); err != nil {
+			t.Fatal(err)
+		}
+
+		writePolicy(t, path, `
+inbound:
+  provider:
+    deny:
+      - "0x2222222222222222222222222222222222222222"
+`)
+		if err := p.Reload(); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Authorize(peer, p2p.DirectionInbound, 0); !errors.Is(err, policy.ErrDenied) {
+			t.Fatalf("expected error %v, got %v", policy.ErrDenied, err)
+		}
+
+		// An invalid file keeps the current policy.
+		writePolicy(t, path, `
+inbound:
+  unknown: {}
+`)
+		if err := p.Reload(); err == nil {
+			t.Fatal("expected error for unknown peer type")
+		}
+		if err := p.Authorize(peer, p2p.DirectionInbound, 0); !errors.Is(err, policy.ErrDenied) {
+			t.Fatalf("expected error %v, got %v", policy.ErrDenied, err)
+		}
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policy.yaml")
+		writePolicy(t, path, `
+outbound:
+  provider:
+    allow:
+      - "0x1234"
+`)
+		if _, err := policy.New(path, newTestLogger()); err == nil {
+			t.Fatal("expected error for invalid address")
+		}
+	})
+}
@@ -0,0 +1,7 @@
+package reputation
+
+import "time"
+
+func (s *Scorer) SetNow(now func() time.Time) {
+	s.now = now
+}
@@ -0,0 +1,45 @@
+package reputation
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	defaultNamespace = "mev_commit"
+	subsystem        = "reputation"
+)
+
+type metrics struct {
+	Events       *prometheus.CounterVec
+	Blocks       prometheus.Counter
+	TrackedPeers prometheus.Gauge
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		Events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "events_count",
+			Help:      "Number of reported peer events",
+		}, []string{"event"}),
+		Blocks: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "blocked_peers_count",
+			Help:      "Number of peers blocked because of their score",
+		}),
+		TrackedPeers: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "tracked_peers",
+			Help:      "Number of peers with a score",
+		}),
+	}
+}
+
+func (s *Scorer) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		s.metrics.Events,
+		s.metrics.Blocks,
+		s.metrics.TrackedPeers,
+	}
+}
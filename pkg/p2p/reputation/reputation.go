@@ -0,0 +1,230 @@
+package reputation
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Event is an observed behaviour of a peer which changes its score.
+type Event int
+
+const (
+	// EventValidBid is reported by providers for bids with a valid signature
+	// from a bidder with enough allowance.
+	EventValidBid Event = iota
+	// EventValidPreconfirmation is reported by bidders for preconfirmations
+	// with a valid signature.
+	EventValidPreconfirmation
+	// EventInvalidSignature is reported for bids or preconfirmations which
+	// fail the signature verification.
+	EventInvalidSignature
+	// EventInsufficientAllowance is reported for bids from bidders without
+	// enough allowance.
+	EventInsufficientAllowance
+	// EventUnansweredBid is reported if a provider doesn't respond to a bid.
+	EventUnansweredBid
+	// EventStreamTimeout is reported if a stream handler times out.
+	EventStreamTimeout
+	// EventMalformedMessage is reported for messages which can't be decoded
+	// or contain invalid data.
+	EventMalformedMessage
+)
+
+func (e Event) String() string {
+	switch e {
+	case EventValidBid:
+		return "valid_bid"
+	case EventValidPreconfirmation:
+		return "valid_preconfirmation"
+	case EventInvalidSignature:
+		return "invalid_signature"
+	case EventInsufficientAllowance:
+		return "insufficient_allowance"
+	case EventUnansweredBid:
+		return "unanswered_bid"
+	case EventStreamTimeout:
+		return "stream_timeout"
+	case EventMalformedMessage:
+		return "malformed_message"
+	default:
+		return "unknown"
+	}
+}
+
+// DefaultWeights are the score changes of the events.
+var DefaultWeights = map[Event]float64{
+	EventValidBid:              1,
+	EventValidPreconfirmation:  1,
+	EventInvalidSignature:      -25,
+	EventInsufficientAllowance: -2,
+	EventUnansweredBid:         -2,
+	EventStreamTimeout:         -5,
+	EventMalformedMessage:      -10,
+}
+
+const pruneInterval = time.Minute
+
+const (
+	// MaxScore and MinScore bound the score of a peer so that a long history
+	// of good behaviour doesn't outweigh recent misbehaviour.
+	MaxScore = 100
+	MinScore = -100
+)
+
+// Blocker blocks peers whose score falls below the threshold.
+type Blocker interface {
+	BlockAddress(ethAddress common.Address, dur time.Duration, reason string) error
+}
+
+type Options struct {
+	// Weights override the score changes of the events.
+	Weights map[Event]float64
+	// HalfLife is the time after which a score has decayed to half of its
+	// value.
+	HalfLife time.Duration
+	// BlockThreshold is the score below which a peer is blocked.
+	BlockThreshold float64
+	// BlockDuration is the duration of the block.
+	BlockDuration time.Duration
+}
+
+// DefaultOptions returns the options used by the node.
+func DefaultOptions() Options {
+	return Options{
+		Weights:        DefaultWeights,
+		HalfLife:       10 * time.Minute,
+		BlockThreshold: -50,
+		BlockDuration:  30 * time.Minute,
+	}
+}
+
+type score struct {
+	value   float64
+	updated time.Time
+}
+
+// Scorer keeps the reputation scores of the peers. The scores decay
+// exponentially towards zero so that peers recover from past misbehaviour.
+type Scorer struct {
+	opts    Options
+	mu      sync.Mutex
+	scores  map[common.Address]*score
+	blocker Blocker
+	logger  *slog.Logger
+	metrics *metrics
+	now     func() time.Time
+	quit    chan struct{}
+}
+
+func New(opts Options, logger *slog.Logger) *Scorer {
+	if opts.Weights == nil {
+		opts.Weights = DefaultWeights
+	}
+	s := &Scorer{
+		opts:    opts,
+		scores:  make(map[common.Address]*score),
+		logger:  logger,
+		metrics: newMetrics(),
+		now:     time.Now,
+		quit:    make(chan struct{}),
+	}
+	go s.pruneLoop()
+	return s
+}
+
+func (s *Scorer) SetBlocker(b Blocker) {
+	s.blocker = b
+}
+
+// decayed returns the value of the score at the given time.
+func (s *Scorer) decayed(sc *score, now time.Time) float64 {
+	if s.opts.HalfLife <= 0 {
+		return sc.value
+	}
+	elapsed := now.Sub(sc.updated)
+	return sc.value * math.Exp2(-float64(elapsed)/float64(s.opts.HalfLife))
+}
+
+// Report changes the score of the peer according to the event. The peer is
+// blocked if its score falls below the threshold.
+func (s *Scorer) Report(ethAddress common.Address, ev Event) {
+	s.metrics.Events.WithLabelValues(ev.String()).Inc()
+
+	now := s.now()
+
+	s.mu.Lock()
+	sc, ok := s.scores[ethAddress]
+	if !ok {
+		sc = &score{updated: now}
+		s.scores[ethAddress] = sc
+	}
+	prev := s.decayed(sc, now)
+	sc.value = math.Max(MinScore, math.Min(MaxScore, prev+s.opts.Weights[ev]))
+	sc.updated = now
+	current := sc.value
+	s.mu.Unlock()
+
+	s.logger.Debug("peer score changed", "peer", ethAddress, "event", ev, "score", current)
+
+	// Only block once when the score crosses the threshold.
+	if prev >= s.opts.BlockThreshold && current < s.opts.BlockThreshold && s.blocker != nil {
+		reason := fmt.Sprintf("reputation score %.1f below threshold", current)
+		if err := s.blocker.BlockAddress(ethAddress, s.opts.BlockDuration, reason); err != nil {
+			s.logger.Error("failed to block peer", "peer", ethAddress, "err", err)
+			return
+		}
+		s.metrics.Blocks.Inc()
+		s.logger.Warn("peer blocked", "peer", ethAddress, "score", current)
+	}
+}
+
+// Score returns the current score of the peer. Unknown peers have a score of
+// zero.
+func (s *Scorer) Score(ethAddress common.Address) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sc, ok := s.scores[ethAddress]
+	if !ok {
+		return 0
+	}
+	return s.decayed(sc, s.now())
+}
+
+func (s *Scorer) pruneLoop() {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-ticker.C:
+			s.prune()
+		}
+	}
+}
+
+// prune removes the scores which have decayed close to zero.
+func (s *Scorer) prune() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for addr, sc := range s.scores {
+		if math.Abs(s.decayed(sc, now)) < 0.01 {
+			delete(s.scores, addr)
+		}
+	}
+	s.metrics.TrackedPeers.Set(float64(len(s.scores)))
+}
+
+func (s *Scorer) Close() error {
+	close(s.quit)
+	return nil
+}
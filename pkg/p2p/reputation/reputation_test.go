@@ -0,0 +1,95 @@
+package reputation_test
+
+import (
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/reputation"
+)
+
+type testBlocker struct {
+	mu      sync.Mutex
+	blocked []common.Address
+}
+
+func (b *testBlocker) BlockAddress(ethAddress common.Address, _ time.Duration, _ string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.blocked = append(b.blocked, ethAddress)
+	return nil
+}
+
+func newTestScorer(t *testing.T, now *time.Time) (*reputation.Scorer, *testBlocker) {
+	t.Helper()
+
+	s := reputation.New(reputation.DefaultOptions(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	s.SetNow(func() time.Time { return *now })
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	b := &testBlocker{}
+	s.SetBlocker(b)
+	return s, b
+}
+
+func TestScorer(t *testing.T) {
+	t.Parallel()
+
+	peer := common.HexToAddress("0x1")
+
+	t.Run("decay", func(t *testing.T) {
+		now := time.Now()
+		s, _ := newTestScorer(t, &now)
+
+		s.Report(peer, reputation.EventMalformedMessage)
+		if score := s.Score(peer); score != -10 {
+			t.Fatalf("expected score -10, got %f", score)
+		}
+
+		now = now.Add(reputation.DefaultOptions().HalfLife)
+		if score := s.Score(peer); score != -5 {
+			t.Fatalf("expected score -5, got %f", score)
+		}
+
+		if score := s.Score(common.HexToAddress("0x2")); score != 0 {
+			t.Fatalf("expected score 0 for unknown peer, got %f", score)
+		}
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		now := time.Now()
+		s, _ := newTestScorer(t, &now)
+
+		for i := 0; i < 2*reputation.MaxScore; i++ {
+			s.Report(peer, reputation.EventValidBid)
+		}
+		if score := s.Score(peer); score != reputation.MaxScore {
+			t.Fatalf("expected score %d, got %f", reputation.MaxScore, score)
+		}
+	})
+
+	t.Run("block below threshold", func(t *testing.T) {
+		now := time.Now()
+		s, b := newTestScorer(t, &now)
+
+		s.Report(peer, reputation.EventInvalidSignature)
+		s.Report(peer, reputation.EventInvalidSignature)
+		if len(b.blocked) != 0 {
+			t.Fatalf("expected no blocks at the threshold, got %d", len(b.blocked))
+		}
+
+		s.Report(peer, reputation.EventMalformedMessage)
+		s.Report(peer, reputation.EventMalformedMessage)
+		if len(b.blocked) != 1 || b.blocked[0] != peer {
+			t.Fatalf("expected peer to be blocked once, got %v", b.blocked)
+		}
+	})
+}
@@ -59,6 +59,7 @@ func pipe(a, b *testStream) {
 		for {
 			msg, ok := <-a.out
 			if !ok {
+				close(b.in)
 				return
 			}
 			b.in <- msg
@@ -69,6 +70,7 @@ func pipe(a, b *testStream) {
 		for {
 			msg, ok := <-b.out
 			if !ok {
+				close(a.in)
 				return
 			}
 			a.in <- msg
@@ -81,6 +83,7 @@ type Option func(*P2PTest)
 type P2PTest struct {
 	self            *p2p.Peer
 	handlers        map[string][]p2p.StreamDesc
+	peers           map[string]p2p.Peer
 	connectFunc     func([]byte) (p2p.Peer, error)
 	addressbookFunc func(p2p.Peer) ([]byte, error)
 }
@@ -100,6 +103,7 @@ func WithAddressbookFunc(fn func(p p2p.Peer) ([]byte, error)) Option {
 func New(selfNode *p2p.Peer, opts ...Option) *P2PTest {
 	p := &P2PTest{
 		handlers: make(map[string][]p2p.StreamDesc),
+		peers:    make(map[string]p2p.Peer),
 		self:     selfNode,
 	}
 
@@ -112,6 +116,16 @@ func New(selfNode *p2p.Peer, opts ...Option) *P2PTest {
 
 func (p *P2PTest) SetPeerHandler(peer p2p.Peer, proto p2p.StreamDesc) {
 	p.handlers[peer.EthAddress.Hex()] = append(p.handlers[peer.EthAddress.Hex()], proto)
+	p.peers[peer.EthAddress.Hex()] = peer
+}
+
+// Peers returns the peers with handlers.
+func (p *P2PTest) Peers() []p2p.Peer {
+	peers := make([]p2p.Peer, 0, len(p.peers))
+	for _, peer := range p.peers {
+		peers = append(peers, peer)
+	}
+	return peers
 }
 
 func (p *P2PTest) Connect(_ context.Context, addr []byte) (p2p.Peer, error) {
@@ -131,7 +145,7 @@ func (p *P2PTest) GetPeerInfo(peer p2p.Peer) ([]byte, error) {
 }
 
 func (p *P2PTest) NewStream(
-	_ context.Context,
+	ctx context.Context,
 	peer p2p.Peer,
 	_ p2p.Header,
 	stream p2p.StreamDesc,
@@ -153,12 +167,20 @@ func (p *P2PTest) NewStream(
 		return nil, errors.New("stream not found")
 	}
 
+	// The idempotency key is the only header the handlers read.
+	handlerCtx := context.Background()
+	if key := p2p.IdempotencyKeyFromContext(ctx); key != "" {
+		hdr := p2p.Header{}
+		hdr.SetIdempotencyKey(key)
+		handlerCtx = p2p.ContextWithHeader(handlerCtx, hdr)
+	}
+
 	out, in := NewDuplexStream()
 
 	go func() {
 		defer in.Close()
 
-		err := handler(context.Background(), *p.self, in)
+		err := handler(handlerCtx, *p.self, in)
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,397 @@
+package preconfirmation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	preconfpb "github.com/primevprotocol/mev-commit/gen/go/preconfirmation/v1"
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/reputation"
+	"github.com/primevprotocol/mev-commit/pkg/topology"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// BidsTopic is the pubsub topic on which bidders broadcast their bids
+	// to all providers.
+	BidsTopic = "bids"
+	// CommitmentsTopic is the pubsub topic on which providers broadcast the
+	// commitments to broadcast bids if commitment gossip is enabled.
+	CommitmentsTopic = "commitments"
+
+	CommitmentProtocolName    = "preconfirmation-commitment"
+	CommitmentProtocolVersion = "1.0.0"
+
+	// maxPendingCommitments is the number of commitments to a broadcast bid
+	// which are buffered for the caller of SendBid.
+	maxPendingCommitments = 100
+)
+
+var (
+	ErrInvalidProviderTypeForCommitment = errors.New("invalid provider type for commitment")
+
+	errUnknownBid            = errors.New("commitment to unknown bid")
+	errMalformedBroadcastBid = errors.New("malformed broadcast bid")
+	errExpiredBid            = errors.New("expired bid")
+)
+
+type PubSub interface {
+	Publish(ctx context.Context, topic string, data []byte) error
+}
+
+// topicRateLimits limit the messages a peer forwards on the topics. Every
+// peer in the mesh forwards the messages of the others, so the same limit
+// applies to all peer types.
+var topicRateLimits = map[p2p.PeerType]p2p.RateLimit{
+	p2p.PeerTypeBidder:   {Rate: 100, Burst: 200},
+	p2p.PeerTypeProvider: {Rate: 100, Burst: 200},
+	p2p.PeerTypeObserver: {Rate: 100, Burst: 200},
+}
+
+// pendingBid collects the commitments to a broadcast bid until the context
+// of SendBid is done.
+type pendingBid struct {
+	commitments chan *preconfpb.PreConfirmation
+	providers   map[common.Address]struct{}
+}
+
+// EnableBroadcast makes bidders publish their bids on the bids topic instead
+// of sending them to every provider. Providers send the commitments back
+// over a direct stream, or publish them on the commitments topic if
+// gossipCommitments is set.
+func (p *Preconfirmation) EnableBroadcast(ps PubSub, gossipCommitments bool) {
+	p.pubsub = ps
+	p.gossipCommitments = gossipCommitments
+}
+
+func (p *Preconfirmation) commitmentStream() p2p.StreamDesc {
+	return p2p.StreamDesc{
+		Name:    CommitmentProtocolName,
+		Version: CommitmentProtocolVersion,
+		Handler: p.handleCommitment,
+		RateLimits: map[p2p.PeerType]p2p.RateLimit{
+			p2p.PeerTypeProvider: {Rate: 50, Burst: 100},
+		},
+		MaxMessageSize: maxMessageSize,
+	}
+}
+
+// BidderStreams returns the stream handlers of bidders which receive the
+// commitments to their broadcast bids.
+func (p *Preconfirmation) BidderStreams() []p2p.StreamDesc {
+	return []p2p.StreamDesc{p.commitmentStream()}
+}
+
+// ProviderTopics returns the pubsub topics of providers in broadcast mode.
+func (p *Preconfirmation) ProviderTopics() []p2p.TopicDesc {
+	topics := []p2p.TopicDesc{{
+		Name:       BidsTopic,
+		Validate:   p.validateBid,
+		Handler:    p.handleBroadcastBid,
+		RateLimits: topicRateLimits,
+	}}
+	if p.gossipCommitments {
+		topics = append(topics, p2p.TopicDesc{
+			Name:       CommitmentsTopic,
+			Validate:   p.validateCommitment,
+			RateLimits: topicRateLimits,
+		})
+	}
+	return topics
+}
+
+// BidderTopics returns the pubsub topics of bidders in broadcast mode.
+func (p *Preconfirmation) BidderTopics() []p2p.TopicDesc {
+	topics := []p2p.TopicDesc{{
+		Name:       BidsTopic,
+		Validate:   p.validateBid,
+		RateLimits: topicRateLimits,
+	}}
+	if p.gossipCommitments {
+		topics = append(topics, p2p.TopicDesc{
+			Name:       CommitmentsTopic,
+			Validate:   p.validateCommitment,
+			Handler:    p.handleBroadcastCommitment,
+			RateLimits: topicRateLimits,
+		})
+	}
+	return topics
+}
+
+// ObserverTopics returns the pubsub topics of observers in broadcast mode.
+// Observers only follow the gossiped commitments, so there are none without
+// commitment gossip.
+func (p *Preconfirmation) ObserverTopics() []p2p.TopicDesc {
+	if !p.gossipCommitments {
+		return nil
+	}
+	return []p2p.TopicDesc{{
+		Name:       CommitmentsTopic,
+		Validate:   p.validateCommitment,
+		Handler:    p.handleObservedCommitment,
+		RateLimits: topicRateLimits,
+	}}
+}
+
+// broadcastBid publishes the signed bid and returns the channel which
+// receives the commitments until the context is done.
+func (p *Preconfirmation) broadcastBid(
+	ctx context.Context,
+	bid *preconfpb.Bid,
+) (chan *preconfpb.PreConfirmation, error) {
+	msg := &preconfpb.BroadcastBid{Bid: bid}
+	if deadline, ok := ctx.Deadline(); ok {
+		msg.Deadline = deadline.UnixMilli()
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	key := string(bid.Digest)
+	pending := &pendingBid{
+		commitments: make(chan *preconfpb.PreConfirmation, maxPendingCommitments),
+		providers:   make(map[common.Address]struct{}),
+	}
+	p.pendingMu.Lock()
+	p.pending[key] = pending
+	p.pendingMu.Unlock()
+
+	// The channel is closed under the lock so that no commitment is
+	// delivered to it afterwards.
+	done := func() {
+		p.pendingMu.Lock()
+		delete(p.pending, key)
+		close(pending.commitments)
+		p.pendingMu.Unlock()
+	}
+
+	if err := p.pubsub.Publish(ctx, BidsTopic, data); err != nil {
+		done()
+		p.logger.Error("publishing bid", "error", err, "txHash", bid.TxHash)
+		return nil, err
+	}
+	p.metrics.SentBidsCount.Inc()
+	p.logger.Info("broadcast signed bid", "signedBid", bid)
+
+	go func() {
+		<-ctx.Done()
+		done()
+	}()
+
+	return pending.commitments, nil
+}
+
+// validateBid drops bids with an invalid signature or from bidders without
+// enough allowance before they are propagated. Observers never submit or
+// forward bids.
+func (p *Preconfirmation) validateBid(ctx context.Context, from p2p.Peer, data []byte) error {
+	if from.Type == p2p.PeerTypeObserver {
+		return ErrInvalidBidderTypeForBid
+	}
+	msg := new(preconfpb.BroadcastBid)
+	if err := proto.Unmarshal(data, msg); err != nil || msg.Bid == nil {
+		p.report(from, reputation.EventMalformedMessage)
+		return errors.Join(errMalformedBroadcastBid, err)
+	}
+	if expired(msg) {
+		return errExpiredBid
+	}
+	_, err := p.verifyBid(ctx, from, msg.Bid)
+	return err
+}
+
+// expired reports whether the bidder doesn't wait for commitments to the bid
+// anymore.
+func expired(msg *preconfpb.BroadcastBid) bool {
+	return msg.Deadline != 0 && !time.Now().Before(time.UnixMilli(msg.Deadline))
+}
+
+// handleBroadcastBid commits to a validated bid and returns the commitment to
+// the bidder.
+func (p *Preconfirmation) handleBroadcastBid(ctx context.Context, from p2p.Peer, data []byte) error {
+	msg := new(preconfpb.BroadcastBid)
+	if err := proto.Unmarshal(data, msg); err != nil || msg.Bid == nil {
+		return errors.Join(errMalformedBroadcastBid, err)
+	}
+	bid := msg.Bid
+	p.logger.Info("received broadcast bid", "bid", bid, "from", from)
+
+	// The deadline of the bidder is carried with the message, the provider
+	// doesn't commit to bids the bidder gave up on.
+	if msg.Deadline != 0 {
+		if expired(msg) {
+			p.logger.Warn("dropping expired broadcast bid", "txHash", bid.TxHash)
+			return nil
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, time.UnixMilli(msg.Deadline))
+		defer cancel()
+	}
+
+	bidder, err := p.signer.VerifyBid(bid)
+	if err != nil {
+		return err
+	}
+
+	// Without commitment gossip the commitment can only be delivered over a
+	// direct stream. The provider doesn't commit to bids of bidders it can't
+	// reach, as the commitment would bind it without being delivered.
+	var bidderPeer p2p.Peer
+	if !p.gossipCommitments {
+		peer, found := p.connectedBidder(*bidder)
+		if !found {
+			return fmt.Errorf("bidder %s is not connected", bidder)
+		}
+		bidderPeer = peer
+	}
+
+	// Bids published again are committed to once.
+	preConfirmation, err := p.commitOnce(ctx, broadcastRequestKey(*bidder, bid), bid)
+	if err != nil || preConfirmation == nil {
+		return err
+	}
+
+	if p.gossipCommitments {
+		data, err := proto.Marshal(preConfirmation)
+		if err != nil {
+			return err
+		}
+		return p.pubsub.Publish(ctx, CommitmentsTopic, data)
+	}
+	return p.sendCommitment(ctx, bidderPeer, preConfirmation)
+}
+
+// connectedBidder returns the bidder if it is connected to the node.
+func (p *Preconfirmation) connectedBidder(bidder common.Address) (p2p.Peer, bool) {
+	bidders := p.topo.GetPeers(topology.Query{Type: p2p.PeerTypeBidder})
+	idx := slices.IndexFunc(bidders, func(b p2p.Peer) bool { return b.EthAddress == bidder })
+	if idx == -1 {
+		return p2p.Peer{}, false
+	}
+	return bidders[idx], true
+}
+
+// sendCommitment sends the commitment over a direct stream to the bidder.
+func (p *Preconfirmation) sendCommitment(
+	ctx context.Context,
+	bidder p2p.Peer,
+	preConfirmation *preconfpb.PreConfirmation,
+) error {
+	stream, err := p.streamer.NewStream(ctx, bidder, nil, p.commitmentStream())
+	if err != nil {
+		return err
+	}
+	if err := stream.WriteMsg(ctx, preConfirmation); err != nil {
+		_ = stream.Reset()
+		return err
+	}
+	return stream.Close()
+}
+
+// handleCommitment receives the commitment of a provider to a broadcast bid.
+func (p *Preconfirmation) handleCommitment(
+	ctx context.Context,
+	peer p2p.Peer,
+	stream p2p.Stream,
+) error {
+	if peer.Type != p2p.PeerTypeProvider {
+		return ErrInvalidProviderTypeForCommitment
+	}
+
+	preConfirmation := new(preconfpb.PreConfirmation)
+	if err := stream.ReadMsg(ctx, preConfirmation); err != nil {
+		return err
+	}
+
+	providerAddress, err := p.signer.VerifyPreConfirmation(preConfirmation)
+	if err != nil {
+		p.report(peer, reputation.EventInvalidSignature)
+		return err
+	}
+	p.report(peer, reputation.EventValidPreconfirmation)
+	return p.deliver(*providerAddress, preConfirmation)
+}
+
+// validateCommitment drops commitments with an invalid signature before they
+// are propagated.
+func (p *Preconfirmation) validateCommitment(_ context.Context, from p2p.Peer, data []byte) error {
+	preConfirmation := new(preconfpb.PreConfirmation)
+	if err := proto.Unmarshal(data, preConfirmation); err != nil {
+		p.report(from, reputation.EventMalformedMessage)
+		return err
+	}
+	if _, err := p.signer.VerifyPreConfirmation(preConfirmation); err != nil {
+		p.report(from, reputation.EventInvalidSignature)
+		return err
+	}
+	return nil
+}
+
+// handleBroadcastCommitment delivers the gossiped commitments to the bids
+// of the node. Commitments to bids of other bidders are ignored.
+func (p *Preconfirmation) handleBroadcastCommitment(_ context.Context, _ p2p.Peer, data []byte) error {
+	preConfirmation := new(preconfpb.PreConfirmation)
+	if err := proto.Unmarshal(data, preConfirmation); err != nil {
+		return err
+	}
+	providerAddress, err := p.signer.VerifyPreConfirmation(preConfirmation)
+	if err != nil {
+		return err
+	}
+	if err := p.deliver(*providerAddress, preConfirmation); err != nil && !errors.Is(err, errUnknownBid) {
+		return err
+	}
+	return nil
+}
+
+// handleObservedCommitment records the gossiped commitments on observers for
+// the debug API.
+func (p *Preconfirmation) handleObservedCommitment(_ context.Context, _ p2p.Peer, data []byte) error {
+	preConfirmation := new(preconfpb.PreConfirmation)
+	if err := proto.Unmarshal(data, preConfirmation); err != nil {
+		return err
+	}
+	providerAddress, err := p.signer.VerifyPreConfirmation(preConfirmation)
+	if err != nil {
+		return err
+	}
+	p.logger.Info("observed preconfirmation", "provider", providerAddress, "preConfirmation", preConfirmation)
+	p.metrics.ObservedPreconfsCount.Inc()
+	p.observe(*providerAddress, preConfirmation)
+	return nil
+}
+
+// deliver passes the commitment to the pending bid. Every provider commits
+// at most once to a bid, duplicates are dropped.
+func (p *Preconfirmation) deliver(provider common.Address, preConfirmation *preconfpb.PreConfirmation) error {
+	if preConfirmation.Bid == nil {
+		return errUnknownBid
+	}
+	preConfirmation.ProviderAddress = provider.Bytes()
+
+	p.pendingMu.Lock()
+	defer p.pendingMu.Unlock()
+
+	pending, found := p.pending[string(preConfirmation.Bid.Digest)]
+	if !found {
+		return errUnknownBid
+	}
+	if _, dup := pending.providers[provider]; dup {
+		return nil
+	}
+	pending.providers[provider] = struct{}{}
+
+	select {
+	case pending.commitments <- preConfirmation:
+		p.logger.Info("received preconfirmation", "preConfirmation", preConfirmation)
+		p.metrics.ReceivedPreconfsCount.Inc()
+	default:
+		p.logger.Warn("dropped preconfirmation", "provider", provider)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package preconfirmation
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	preconfpb "github.com/primevprotocol/mev-commit/gen/go/preconfirmation/v1"
+	"google.golang.org/grpc/status"
+)
+
+// committedBidTTL is how long the commitment to a bid is kept for the retries
+// of the bidder.
+const committedBidTTL = time.Minute
+
+// bidRequest is a bid received with an idempotency key. Retries of the bid
+// wait for the first request and receive its result.
+type bidRequest struct {
+	digest     []byte
+	done       chan struct{}
+	commitment *preconfpb.PreConfirmation
+	err        error
+	// expires is set once the bid is committed.
+	expires time.Time
+}
+
+// requestKey scopes the idempotency key of the request to the bidder so
+// that the keys of different bidders never collide.
+func requestKey(bidder common.Address, key string) string {
+	if key == "" {
+		return ""
+	}
+	return bidder.Hex() + "/" + key
+}
+
+// broadcastRequestKey identifies a broadcast bid by its digest. The bid may
+// be delivered more than once by the pubsub, e.g. if the bidder publishes it
+// again.
+func broadcastRequestKey(bidder common.Address, bid *preconfpb.Bid) string {
+	return requestKey(bidder, hex.EncodeToString(bid.Digest))
+}
+
+// commitOnce commits to the bid once per request key. The bid is processed
+// again if the key is empty, belongs to another bid or the previous request
+// failed.
+func (p *Preconfirmation) commitOnce(
+	ctx context.Context,
+	key string,
+	bid *preconfpb.Bid,
+) (*preconfpb.PreConfirmation, error) {
+	if key == "" {
+		return p.commit(ctx, bid)
+	}
+
+	var r *bidRequest
+	for r == nil {
+		p.requestsMu.Lock()
+		now := time.Now()
+		for k, req := range p.requests {
+			if !req.expires.IsZero() && now.After(req.expires) {
+				delete(p.requests, k)
+			}
+		}
+		prev, found := p.requests[key]
+		if !found || !bytes.Equal(prev.digest, bid.Digest) {
+			r = &bidRequest{
+				digest: bid.Digest,
+				done:   make(chan struct{}),
+			}
+			p.requests[key] = r
+			p.requestsMu.Unlock()
+			break
+		}
+		p.requestsMu.Unlock()
+
+		p.logger.Info("received retried bid", "txHash", bid.TxHash, "idempotencyKey", key)
+		select {
+		case <-prev.done:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		// The bid is processed again if the previous request failed, e.g.
+		// because the bidder gave up on it.
+		if prev.err == nil {
+			return prev.commitment, nil
+		}
+	}
+
+	r.commitment, r.err = p.commit(ctx, bid)
+
+	p.requestsMu.Lock()
+	if r.err != nil {
+		if p.requests[key] == r {
+			delete(p.requests, key)
+		}
+	} else {
+		r.expires = time.Now().Add(committedBidTTL)
+	}
+	p.requestsMu.Unlock()
+	close(r.done)
+
+	return r.commitment, r.err
+}
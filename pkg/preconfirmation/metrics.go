@@ -10,6 +10,7 @@ const (
 type metrics struct {
 	SentBidsCount         prometheus.Counter
 	ReceivedPreconfsCount prometheus.Counter
+	ObservedPreconfsCount prometheus.Counter
 }
 
 func newMetrics() *metrics {
@@ -26,6 +27,12 @@ func newMetrics() *metrics {
 			Name:      "received_preconfs_count",
 			Help:      "Number of received preconfirmations",
 		}),
+		ObservedPreconfsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "observed_preconfs_count",
+			Help:      "Number of preconfirmations observed on the commitments topic",
+		}),
 	}
 }
 
@@ -33,5 +40,6 @@ func (p *Preconfirmation) Metrics() []prometheus.Collector {
 	return []prometheus.Collector{
 		p.metrics.SentBidsCount,
 		p.metrics.ReceivedPreconfsCount,
+		p.metrics.ObservedPreconfsCount,
 	}
 }
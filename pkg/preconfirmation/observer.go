@@ -0,0 +1,101 @@
+package preconfirmation
+
+import (
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	preconfpb "github.com/primevprotocol/mev-commit/gen/go/preconfirmation/v1"
+)
+
+// maxObservedCommitments is the number of the latest gossiped commitments
+// which observers keep for the debug API.
+const maxObservedCommitments = 1000
+
+// ObservedCommitment is a gossiped commitment seen by an observer.
+type ObservedCommitment struct {
+	Provider            common.Address `json:"provider"`
+	TxHash              string         `json:"tx_hash"`
+	BidAmount           string         `json:"bid_amount"`
+	BlockNumber         int64          `json:"block_number"`
+	DecayStartTimestamp int64          `json:"decay_start_timestamp"`
+	DecayEndTimestamp   int64          `json:"decay_end_timestamp"`
+	BidDigest           string         `json:"bid_digest"`
+	CommitmentDigest    string         `json:"commitment_digest"`
+	ObservedAt          time.Time      `json:"observed_at"`
+}
+
+// ObservedProvider summarizes the gossiped commitments of a provider seen by
+// an observer.
+type ObservedProvider struct {
+	Address        common.Address `json:"address"`
+	Commitments    uint64         `json:"commitments"`
+	LastCommitment time.Time      `json:"last_commitment"`
+}
+
+// observe records the gossiped commitment of the provider. The oldest
+// commitments are dropped once maxObservedCommitments are kept.
+func (p *Preconfirmation) observe(provider common.Address, preConfirmation *preconfpb.PreConfirmation) {
+	c := ObservedCommitment{
+		Provider:         provider,
+		CommitmentDigest: hex.EncodeToString(preConfirmation.Digest),
+		ObservedAt:       time.Now(),
+	}
+	if bid := preConfirmation.Bid; bid != nil {
+		c.TxHash = bid.TxHash
+		c.BidAmount = bid.BidAmount
+		c.BlockNumber = bid.BlockNumber
+		c.DecayStartTimestamp = bid.DecayStartTimestamp
+		c.DecayEndTimestamp = bid.DecayEndTimestamp
+		c.BidDigest = hex.EncodeToString(bid.Digest)
+	}
+
+	p.observedMu.Lock()
+	defer p.observedMu.Unlock()
+
+	if len(p.observed) == maxObservedCommitments {
+		p.observed = slices.Delete(p.observed, 0, 1)
+	}
+	p.observed = append(p.observed, c)
+
+	op, found := p.observedProviders[provider]
+	if !found {
+		op = &ObservedProvider{Address: provider}
+		p.observedProviders[provider] = op
+	}
+	op.Commitments++
+	op.LastCommitment = c.ObservedAt
+}
+
+// ObservedCommitments returns the latest gossiped commitments seen by the
+// observer, the newest first. Only the commitments of the provider are
+// returned if it is set.
+func (p *Preconfirmation) ObservedCommitments(provider *common.Address) []ObservedCommitment {
+	p.observedMu.Lock()
+	defer p.observedMu.Unlock()
+
+	res := make([]ObservedCommitment, 0, len(p.observed))
+	for i := len(p.observed) - 1; i >= 0; i-- {
+		if provider == nil || p.observed[i].Provider == *provider {
+			res = append(res, p.observed[i])
+		}
+	}
+	return res
+}
+
+// ObservedProviders returns the providers whose gossiped commitments were
+// seen by the observer, the most recently active first.
+func (p *Preconfirmation) ObservedProviders() []ObservedProvider {
+	p.observedMu.Lock()
+	defer p.observedMu.Unlock()
+
+	res := make([]ObservedProvider, 0, len(p.observedProviders))
+	for _, op := range p.observedProviders {
+		res = append(res, *op)
+	}
+	slices.SortFunc(res, func(a, b ObservedProvider) int {
+		return b.LastCommitment.Compare(a.LastCommitment)
+	})
+	return res
+}
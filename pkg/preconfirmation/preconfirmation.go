@@ -2,6 +2,7 @@ package preconfirmation
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"log/slog"
 	"math/big"
@@ -13,8 +14,13 @@ import (
 	providerapiv1 "github.com/primevprotocol/mev-commit/gen/go/providerapi/v1"
 	preconfcontract "github.com/primevprotocol/mev-commit/pkg/contracts/preconf"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/p2p/reputation"
 	signer "github.com/primevprotocol/mev-commit/pkg/signer/preconfsigner"
 	"github.com/primevprotocol/mev-commit/pkg/topology"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -24,6 +30,15 @@ const (
 	ProtocolVersion = "2.0.0"
 )
 
+// defaultBidTimeout limits the time the provider takes to decide on a bid.
+// Bidders may only ask for a shorter deadline.
+const defaultBidTimeout = 5 * time.Second
+
+// maxMessageSize limits the size of the bids and commitments on the streams.
+const maxMessageSize = 64 << 10
+
+var tracer = otel.Tracer("github.com/primevprotocol/mev-commit/pkg/preconfirmation")
+
 type Preconfirmation struct {
 	signer       signer.Signer
 	topo         Topology
@@ -31,8 +46,22 @@ type Preconfirmation struct {
 	us           BidderStore
 	processer    BidProcessor
 	commitmentDA preconfcontract.Interface
+	reporter     Reporter
+	selection    topology.Query
 	logger       *slog.Logger
 	metrics      *metrics
+
+	pubsub            PubSub
+	gossipCommitments bool
+	pendingMu         sync.Mutex
+	pending           map[string]*pendingBid
+
+	requestsMu sync.Mutex
+	requests   map[string]*bidRequest
+
+	observedMu        sync.Mutex
+	observed          []ObservedCommitment
+	observedProviders map[common.Address]*ObservedProvider
 }
 
 type Topology interface {
@@ -43,6 +72,11 @@ type BidderStore interface {
 	CheckBidderAllowance(context.Context, common.Address) bool
 }
 
+// Reporter receives the events which affect the reputation of peers.
+type Reporter interface {
+	Report(common.Address, reputation.Event)
+}
+
 type BidProcessor interface {
 	ProcessBid(context.Context, *preconfpb.Bid) (chan providerapiv1.BidResponse_Status, error)
 }
@@ -65,6 +99,27 @@ func New(
 		commitmentDA: commitmentDA,
 		logger:       logger,
 		metrics:      newMetrics(),
+		pending:      make(map[string]*pendingBid),
+		requests:     make(map[string]*bidRequest),
+
+		observedProviders: make(map[common.Address]*ObservedProvider),
+	}
+}
+
+func (p *Preconfirmation) SetReporter(r Reporter) {
+	p.reporter = r
+}
+
+// SetProviderSelection restricts the providers which receive the bids to the
+// ones matching the query. The peer type and the protocol are always set by
+// the preconfirmation protocol.
+func (p *Preconfirmation) SetProviderSelection(q topology.Query) {
+	p.selection = q
+}
+
+func (p *Preconfirmation) report(peer p2p.Peer, ev reputation.Event) {
+	if p.reporter != nil {
+		p.reporter.Report(peer.EthAddress, ev)
 	}
 }
 
@@ -73,6 +128,10 @@ func (p *Preconfirmation) preconfStream() p2p.StreamDesc {
 		Name:    ProtocolName,
 		Version: ProtocolVersion,
 		Handler: p.handleBid,
+		RateLimits: map[p2p.PeerType]p2p.RateLimit{
+			p2p.PeerTypeBidder: {Rate: 50, Burst: 100},
+		},
+		MaxMessageSize: maxMessageSize,
 	}
 }
 
@@ -92,18 +151,40 @@ func (p *Preconfirmation) SendBid(
 	decayStartTimestamp int64,
 	decayEndTimestamp int64,
 ) (chan *preconfpb.PreConfirmation, error) {
+	// The span ends once all the providers answered.
+	ctx, span := tracer.Start(ctx, "SendBid", trace.WithAttributes(attribute.String("bid.tx_hash", txHash)))
+
 	signedBid, err := p.signer.ConstructSignedBid(txHash, bidAmt, blockNumber, decayStartTimestamp, decayEndTimestamp)
 	if err != nil {
 		p.logger.Error("constructing signed bid", "error", err, "txHash", txHash)
+		tracing.EndSpan(span, err)
 		return nil, err
 	}
 	p.logger.Info("constructed signed bid", "signedBid", signedBid)
 
-	providers := p.topo.GetPeers(topology.Query{Type: p2p.PeerTypeProvider})
+	if p.pubsub != nil {
+		commitments, err := p.broadcastBid(ctx, signedBid)
+		tracing.EndSpan(span, err)
+		return commitments, err
+	}
+
+	query := p.selection
+	query.Type = p2p.PeerTypeProvider
+	query.Protocol = p2p.ProtocolInfo{
+		Name:    ProtocolName,
+		Version: ProtocolVersion,
+	}
+	providers := p.topo.GetPeers(query)
 	if len(providers) == 0 {
 		p.logger.Error("no providers available", "txHash", txHash)
-		return nil, errors.New("no providers available")
+		err := errors.New("no providers available")
+		tracing.EndSpan(span, err)
+		return nil, err
 	}
+	span.SetAttributes(attribute.Int("providers", len(providers)))
+
+	// Providers can recognize retries of the bid by its digest.
+	ctx = p2p.WithIdempotencyKey(ctx, hex.EncodeToString(signedBid.Digest))
 
 	// Create a new channel to receive preConfirmations
 	preConfirmations := make(chan *preconfpb.PreConfirmation, len(providers))
@@ -116,6 +197,14 @@ func (p *Preconfirmation) SendBid(
 
 			logger := p.logger.With("provider", provider, "bid", txHash)
 
+			ctx, span := tracer.Start(
+				ctx,
+				"SendBid/provider",
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attribute.String("peer.address", provider.EthAddress.Hex())),
+			)
+			defer span.End()
+
 			providerStream, err := p.streamer.NewStream(
 				ctx,
 				provider,
@@ -142,6 +231,11 @@ func (p *Preconfirmation) SendBid(
 			if err != nil {
 				_ = providerStream.Reset()
 				logger.Error("reading message", "error", err)
+				// Rejected bids are answered with an error status, only
+				// count providers which didn't answer at all.
+				if _, isStatus := status.FromError(err); !isStatus && ctx.Err() == nil {
+					p.report(provider, reputation.EventUnansweredBid)
+				}
 				return
 			}
 
@@ -151,8 +245,10 @@ func (p *Preconfirmation) SendBid(
 			providerAddress, err := p.signer.VerifyPreConfirmation(preConfirmation)
 			if err != nil {
 				logger.Error("verifying provider signature", "error", err)
+				p.report(provider, reputation.EventInvalidSignature)
 				return
 			}
+			p.report(provider, reputation.EventValidPreconfirmation)
 			preConfirmation.ProviderAddress = make([]byte, len(providerAddress))
 			copy(preConfirmation.ProviderAddress, providerAddress[:])
 			logger.Info("received preconfirmation", "preConfirmation", preConfirmation)
@@ -170,6 +266,7 @@ func (p *Preconfirmation) SendBid(
 	go func() {
 		wg.Wait()
 		close(preConfirmations)
+		span.End()
 	}()
 
 	return preConfirmations, nil
@@ -177,6 +274,9 @@ func (p *Preconfirmation) SendBid(
 
 var ErrInvalidBidderTypeForBid = errors.New("invalid bidder type for bid")
 
+// errNoBidStatus is returned if the provider made no decision on a bid.
+var errNoBidStatus = status.Error(codes.Internal, "no decision on bid")
+
 // handlebid is the function that is called when a bid is received
 // It is meant to be used by the provider exclusively to read the bid value from the bidder.
 func (p *Preconfirmation) handleBid(
@@ -194,59 +294,124 @@ func (p *Preconfirmation) handleBid(
 		return err
 	}
 
-	p.logger.Info("received bid", "bid", bid)
+	p.logger.Info("received bid", "bid", bid, "requestID", p2p.RequestIDFromContext(ctx))
+
+	if _, err := p.verifyBid(ctx, peer, bid); err != nil {
+		return err
+	}
+	p.report(peer, reputation.EventValidBid)
+
+	key := requestKey(peer.EthAddress, p2p.HeaderFromContext(ctx).IdempotencyKey())
+	preConfirmation, err := p.commitOnce(ctx, key, bid)
+	if err != nil {
+		return err
+	}
+	return stream.WriteMsg(ctx, preConfirmation)
+}
+
+// verifyBid checks the signature of the bid and the allowance of the bidder.
+// The peer which sent the bid is reported if it fails.
+func (p *Preconfirmation) verifyBid(
+	ctx context.Context,
+	peer p2p.Peer,
+	bid *preconfpb.Bid,
+) (ethAddress *common.Address, err error) {
+	ctx, span := tracer.Start(ctx, "VerifyBid", trace.WithAttributes(attribute.String("bid.tx_hash", bid.TxHash)))
+	defer func() { tracing.EndSpan(span, err) }()
 
-	ethAddress, err := p.signer.VerifyBid(bid)
+	ethAddress, err = p.signer.VerifyBid(bid)
 	if err != nil {
 		p.logger.Error("verifying bid", "error", err)
-		return status.Errorf(codes.InvalidArgument, "invalid bid: %v", err)
+		p.report(peer, reputation.EventInvalidSignature)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bid: %v", err)
 	}
+	span.SetAttributes(attribute.String("bidder.address", ethAddress.Hex()))
 
-	if !p.us.CheckBidderAllowance(ctx, *ethAddress) {
+	_, allowanceSpan := tracer.Start(ctx, "CheckBidderAllowance")
+	allowed := p.us.CheckBidderAllowance(ctx, *ethAddress)
+	allowanceSpan.SetAttributes(attribute.Bool("allowed", allowed))
+	allowanceSpan.End()
+	if !allowed {
 		p.logger.Error("bidder does not have enough allowance", "ethAddress", ethAddress)
-		return status.Errorf(codes.FailedPrecondition, "bidder not allowed")
+		p.report(peer, reputation.EventInsufficientAllowance)
+		return nil, status.Errorf(codes.FailedPrecondition, "bidder not allowed")
 	}
+	return ethAddress, nil
+}
 
+// commit hands a verified bid to the provider and stores the commitment if
+// the provider accepts it.
+func (p *Preconfirmation) commit(
+	ctx context.Context,
+	bid *preconfpb.Bid,
+) (*preconfpb.PreConfirmation, error) {
 	bidAmt, _ := new(big.Int).SetString(bid.BidAmount, 10)
 
-	// try to enqueue for 5 seconds
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	// The deadline of the bidder is propagated with the stream or the
+	// broadcast message, it is kept if it is earlier than the default
+	// timeout.
+	ctx, cancel := context.WithTimeout(ctx, defaultBidTimeout)
 	defer cancel()
 
+	st, err := p.processBid(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+	switch st {
+	case providerapiv1.BidResponse_STATUS_REJECTED:
+		return nil, status.Errorf(codes.Internal, "bid rejected")
+	case providerapiv1.BidResponse_STATUS_ACCEPTED:
+		// The bidder doesn't wait for the commitment anymore.
+		if err := ctx.Err(); err != nil {
+			p.logger.Warn("dropping expired bid", "txHash", bid.TxHash, "error", err)
+			return nil, status.FromContextError(err).Err()
+		}
+		preConfirmation, err := p.signer.ConstructPreConfirmation(bid)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to construct preconfirmation: %v", err)
+		}
+		p.logger.Info("sending preconfirmation", "preConfirmation", preConfirmation)
+		spanCtx, span := tracer.Start(ctx, "StoreCommitment")
+		err = p.commitmentDA.StoreCommitment(
+			spanCtx,
+			bidAmt,
+			uint64(preConfirmation.Bid.BlockNumber),
+			preConfirmation.Bid.TxHash,
+			uint64(preConfirmation.Bid.DecayStartTimestamp),
+			uint64(preConfirmation.Bid.DecayEndTimestamp),
+			preConfirmation.Bid.Signature,
+			preConfirmation.Signature,
+		)
+		tracing.EndSpan(span, err)
+		if err != nil {
+			p.logger.Error("storing commitment", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to store commitment: %v", err)
+		}
+		return preConfirmation, nil
+	default:
+		return nil, errNoBidStatus
+	}
+}
+
+// processBid hands the bid to the provider and waits for the decision.
+func (p *Preconfirmation) processBid(
+	ctx context.Context,
+	bid *preconfpb.Bid,
+) (st providerapiv1.BidResponse_Status, err error) {
+	ctx, span := tracer.Start(ctx, "ProcessBid")
+	defer func() {
+		span.SetAttributes(attribute.String("bid.status", st.String()))
+		tracing.EndSpan(span, err)
+	}()
+
 	statusC, err := p.processer.ProcessBid(ctx, bid)
 	if err != nil {
-		return err
+		return st, err
 	}
 	select {
 	case <-ctx.Done():
-		return ctx.Err()
-	case st := <-statusC:
-		switch st {
-		case providerapiv1.BidResponse_STATUS_REJECTED:
-			return status.Errorf(codes.Internal, "bid rejected")
-		case providerapiv1.BidResponse_STATUS_ACCEPTED:
-			preConfirmation, err := p.signer.ConstructPreConfirmation(bid)
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to construct preconfirmation: %v", err)
-			}
-			p.logger.Info("sending preconfirmation", "preConfirmation", preConfirmation)
-			err = p.commitmentDA.StoreCommitment(
-				ctx,
-				bidAmt,
-				uint64(preConfirmation.Bid.BlockNumber),
-				preConfirmation.Bid.TxHash,
-				uint64(preConfirmation.Bid.DecayStartTimestamp),
-				uint64(preConfirmation.Bid.DecayEndTimestamp),
-				preConfirmation.Bid.Signature,
-				preConfirmation.Signature,
-			)
-			if err != nil {
-				p.logger.Error("storing commitment", "error", err)
-				return status.Errorf(codes.Internal, "failed to store commitment: %v", err)
-			}
-			return stream.WriteMsg(ctx, preConfirmation)
-		}
+		return st, ctx.Err()
+	case res := <-statusC:
+		return res, nil
 	}
-
-	return nil
 }
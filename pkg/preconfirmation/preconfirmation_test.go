@@ -2,10 +2,13 @@ package preconfirmation_test
 
 import (
 	"context"
+	"encoding/hex"
 	"io"
 	"log/slog"
 	"math/big"
 	"os"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	p2ptest "github.com/primevprotocol/mev-commit/pkg/p2p/testing"
 	"github.com/primevprotocol/mev-commit/pkg/preconfirmation"
 	"github.com/primevprotocol/mev-commit/pkg/topology"
+	"google.golang.org/protobuf/proto"
 )
 
 type testTopo struct {
@@ -68,6 +72,25 @@ func (t *testProcessor) ProcessBid(
 	return statusC, nil
 }
 
+// countingProcessor accepts all bids and counts them.
+type countingProcessor struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (t *countingProcessor) ProcessBid(
+	_ context.Context,
+	_ *preconfpb.Bid,
+) (chan providerapiv1.BidResponse_Status, error) {
+	t.mu.Lock()
+	t.count++
+	t.mu.Unlock()
+
+	statusC := make(chan providerapiv1.BidResponse_Status, 1)
+	statusC <- providerapiv1.BidResponse_STATUS_ACCEPTED
+	return statusC, nil
+}
+
 type testCommitmentDA struct{}
 
 func (t *testCommitmentDA) StoreCommitment(
@@ -96,6 +119,45 @@ func newTestLogger(t *testing.T, w io.Writer) *slog.Logger {
 	return slog.New(testLogger)
 }
 
+// testPubSub delivers published messages to the validators and handlers of
+// all the registered topics.
+type testPubSub struct {
+	mu     sync.Mutex
+	topics map[string][]p2p.TopicDesc
+}
+
+func (ps *testPubSub) AddTopics(topics ...p2p.TopicDesc) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if ps.topics == nil {
+		ps.topics = make(map[string][]p2p.TopicDesc)
+	}
+	for _, t := range topics {
+		ps.topics[t.Name] = append(ps.topics[t.Name], t)
+	}
+}
+
+func (ps *testPubSub) Publish(ctx context.Context, topic string, data []byte) error {
+	ps.mu.Lock()
+	descs := ps.topics[topic]
+	ps.mu.Unlock()
+
+	for _, desc := range descs {
+		if err := desc.Validate(ctx, p2p.Peer{}, data); err != nil {
+			return err
+		}
+	}
+	for _, desc := range descs {
+		if desc.Handler != nil {
+			go func(desc p2p.TopicDesc) {
+				_ = desc.Handler(context.Background(), p2p.Peer{}, data)
+			}(desc)
+		}
+	}
+	return nil
+}
+
 func TestPreconfBidSubmission(t *testing.T) {
 	t.Parallel()
 
@@ -168,4 +230,358 @@ func TestPreconfBidSubmission(t *testing.T) {
 			t.Fatalf("preConfirmation signature is not equal to test")
 		}
 	})
+	t.Run("retry", func(t *testing.T) {
+		client := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeBidder,
+		}
+		server := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeProvider,
+		}
+
+		bid := &preconfpb.Bid{
+			TxHash:              "test",
+			BidAmount:           "10",
+			BlockNumber:         10,
+			DecayStartTimestamp: time.Now().UnixMilli() - 10000*time.Millisecond.Milliseconds(),
+			DecayEndTimestamp:   time.Now().UnixMilli(),
+			Digest:              []byte("test"),
+			Signature:           []byte("test"),
+		}
+
+		preConfirmation := &preconfpb.PreConfirmation{
+			Bid:       bid,
+			Digest:    []byte("test"),
+			Signature: []byte("test"),
+		}
+
+		svc := p2ptest.New(&client)
+		proc := &countingProcessor{}
+		p := preconfirmation.New(
+			&testTopo{server},
+			svc,
+			&testSigner{
+				bid:                   bid,
+				preConfirmation:       preConfirmation,
+				bidSigner:             common.HexToAddress("0x1"),
+				preConfirmationSigner: common.HexToAddress("0x2"),
+			},
+			&testBidderStore{},
+			proc,
+			&testCommitmentDA{},
+			newTestLogger(t, os.Stdout),
+		)
+		svc.SetPeerHandler(server, p.Streams()[0])
+
+		// The retries carry the same idempotency key, the provider commits
+		// to the bid once.
+		for i := 0; i < 2; i++ {
+			respC, err := p.SendBid(context.Background(), bid.TxHash, bid.BidAmount, bid.BlockNumber, bid.DecayStartTimestamp, bid.DecayEndTimestamp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			commitment := <-respC
+			if commitment == nil || string(commitment.Digest) != "test" {
+				t.Fatalf("unexpected commitment %v", commitment)
+			}
+		}
+
+		proc.mu.Lock()
+		defer proc.mu.Unlock()
+		if proc.count != 1 {
+			t.Fatalf("expected bid to be processed once, got %d", proc.count)
+		}
+	})
+	t.Run("broadcast", func(t *testing.T) {
+		bidder := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeBidder,
+		}
+		provider := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeProvider,
+		}
+
+		bid := &preconfpb.Bid{
+			TxHash:              "test",
+			BidAmount:           "10",
+			BlockNumber:         10,
+			DecayStartTimestamp: time.Now().UnixMilli() - 10000*time.Millisecond.Milliseconds(),
+			DecayEndTimestamp:   time.Now().UnixMilli(),
+			Digest:              []byte("bid"),
+			Signature:           []byte("test"),
+		}
+		preConfirmation := &preconfpb.PreConfirmation{
+			Bid:       bid,
+			Digest:    []byte("commitment"),
+			Signature: []byte("test"),
+		}
+		signer := &testSigner{
+			bid:                   bid,
+			preConfirmation:       preConfirmation,
+			bidSigner:             bidder.EthAddress,
+			preConfirmationSigner: provider.EthAddress,
+		}
+
+		for _, gossipCommitments := range []bool{false, true} {
+			ps := &testPubSub{}
+
+			bidderSvc := p2ptest.New(&provider)
+			bidderProto := preconfirmation.New(
+				&testTopo{provider},
+				bidderSvc,
+				signer,
+				&testBidderStore{},
+				&testProcessor{},
+				&testCommitmentDA{},
+				newTestLogger(t, os.Stdout),
+			)
+			bidderProto.EnableBroadcast(ps, gossipCommitments)
+			ps.AddTopics(bidderProto.BidderTopics()...)
+
+			// The provider sends the commitment to the bidder over a
+			// stream if it is not gossiped.
+			providerSvc := p2ptest.New(&provider)
+			providerSvc.SetPeerHandler(bidder, bidderProto.BidderStreams()[0])
+			providerProto := preconfirmation.New(
+				&testTopo{bidder},
+				providerSvc,
+				signer,
+				&testBidderStore{},
+				&testProcessor{status: providerapiv1.BidResponse_STATUS_ACCEPTED},
+				&testCommitmentDA{},
+				newTestLogger(t, os.Stdout),
+			)
+			providerProto.EnableBroadcast(ps, gossipCommitments)
+			ps.AddTopics(providerProto.ProviderTopics()...)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			respC, err := bidderProto.SendBid(ctx, bid.TxHash, bid.BidAmount, bid.BlockNumber, bid.DecayStartTimestamp, bid.DecayEndTimestamp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			commitment := <-respC
+			if commitment == nil || string(commitment.Digest) != "commitment" {
+				t.Fatalf("unexpected commitment %v with gossip %t", commitment, gossipCommitments)
+			}
+			if common.BytesToAddress(commitment.ProviderAddress) != provider.EthAddress {
+				t.Fatalf("unexpected provider %x", commitment.ProviderAddress)
+			}
+
+			cancel()
+			if _, open := <-respC; open {
+				t.Fatal("expected channel to be closed")
+			}
+		}
+	})
+	t.Run("broadcast unreachable bidder", func(t *testing.T) {
+		provider := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeProvider,
+		}
+
+		bid := &preconfpb.Bid{
+			TxHash:              "test",
+			BidAmount:           "10",
+			BlockNumber:         10,
+			DecayStartTimestamp: time.Now().UnixMilli() - 10000*time.Millisecond.Milliseconds(),
+			DecayEndTimestamp:   time.Now().UnixMilli(),
+			Digest:              []byte("bid"),
+			Signature:           []byte("test"),
+		}
+
+		proc := &countingProcessor{}
+		p := preconfirmation.New(
+			&testTopo{provider},
+			p2ptest.New(&provider),
+			&testSigner{
+				bid:                   bid,
+				bidSigner:             common.HexToAddress("0x1"),
+				preConfirmationSigner: provider.EthAddress,
+			},
+			&testBidderStore{},
+			proc,
+			&testCommitmentDA{},
+			newTestLogger(t, os.Stdout),
+		)
+		p.EnableBroadcast(&testPubSub{}, false)
+
+		data, err := proto.Marshal(&preconfpb.BroadcastBid{Bid: bid})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The bidder isn't connected, the commitment can't be delivered
+		// so the provider doesn't commit to the bid.
+		handler := bidsTopic(t, p.ProviderTopics()).Handler
+		if err := handler(context.Background(), p2p.Peer{}, data); err == nil {
+			t.Fatal("expected error")
+		}
+
+		proc.mu.Lock()
+		defer proc.mu.Unlock()
+		if proc.count != 0 {
+			t.Fatalf("expected bid not to be processed, got %d", proc.count)
+		}
+	})
+	t.Run("broadcast deadline and republish", func(t *testing.T) {
+		bidder := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeBidder,
+		}
+		provider := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeProvider,
+		}
+
+		bid := &preconfpb.Bid{
+			TxHash:              "test",
+			BidAmount:           "10",
+			BlockNumber:         10,
+			DecayStartTimestamp: time.Now().UnixMilli() - 10000*time.Millisecond.Milliseconds(),
+			DecayEndTimestamp:   time.Now().UnixMilli(),
+			Digest:              []byte("bid"),
+			Signature:           []byte("test"),
+		}
+
+		proc := &countingProcessor{}
+		p := preconfirmation.New(
+			&testTopo{bidder},
+			p2ptest.New(&provider),
+			&testSigner{
+				bid: bid,
+				preConfirmation: &preconfpb.PreConfirmation{
+					Bid:       bid,
+					Digest:    []byte("commitment"),
+					Signature: []byte("test"),
+				},
+				bidSigner:             bidder.EthAddress,
+				preConfirmationSigner: provider.EthAddress,
+			},
+			&testBidderStore{},
+			proc,
+			&testCommitmentDA{},
+			newTestLogger(t, os.Stdout),
+		)
+		p.EnableBroadcast(&testPubSub{}, true)
+		topic := bidsTopic(t, p.ProviderTopics())
+
+		// The bidder doesn't wait for commitments to the expired bid.
+		expired, err := proto.Marshal(&preconfpb.BroadcastBid{
+			Bid:      bid,
+			Deadline: time.Now().Add(-time.Second).UnixMilli(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := topic.Validate(context.Background(), bidder, expired); err == nil {
+			t.Fatal("expected expired bid to be rejected")
+		}
+		if err := topic.Handler(context.Background(), bidder, expired); err != nil {
+			t.Fatal(err)
+		}
+
+		// The bid published again is committed to once.
+		data, err := proto.Marshal(&preconfpb.BroadcastBid{
+			Bid:      bid,
+			Deadline: time.Now().Add(time.Minute).UnixMilli(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 2; i++ {
+			if err := topic.Handler(context.Background(), bidder, data); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		proc.mu.Lock()
+		defer proc.mu.Unlock()
+		if proc.count != 1 {
+			t.Fatalf("expected bid to be processed once, got %d", proc.count)
+		}
+	})
+}
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+
+	provider := common.HexToAddress("0x2")
+	bid := &preconfpb.Bid{
+		TxHash:      "test",
+		BidAmount:   "10",
+		BlockNumber: 10,
+		Digest:      []byte("bid"),
+		Signature:   []byte("test"),
+	}
+	observer := p2p.Peer{
+		EthAddress: common.HexToAddress("0x3"),
+		Type:       p2p.PeerTypeObserver,
+	}
+
+	p := preconfirmation.New(
+		&testTopo{},
+		p2ptest.New(&observer),
+		&testSigner{bid: bid, preConfirmationSigner: provider},
+		&testBidderStore{},
+		&testProcessor{},
+		&testCommitmentDA{},
+		newTestLogger(t, os.Stdout),
+	)
+	p.EnableBroadcast(&testPubSub{}, true)
+
+	topics := p.ObserverTopics()
+	if len(topics) != 1 || topics[0].Name != preconfirmation.CommitmentsTopic {
+		t.Fatalf("expected commitments topic, got %v", topics)
+	}
+
+	for _, digest := range []string{"commitment1", "commitment2"} {
+		data, err := proto.Marshal(&preconfpb.PreConfirmation{
+			Bid:       bid,
+			Digest:    []byte(digest),
+			Signature: []byte("test"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := topics[0].Handler(context.Background(), p2p.Peer{}, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	commitments := p.ObservedCommitments(nil)
+	if len(commitments) != 2 {
+		t.Fatalf("expected 2 observed commitments, got %d", len(commitments))
+	}
+	// The newest commitment comes first.
+	if commitments[0].CommitmentDigest != hex.EncodeToString([]byte("commitment2")) {
+		t.Fatalf("unexpected commitment %+v", commitments[0])
+	}
+	if commitments[0].Provider != provider || commitments[0].TxHash != bid.TxHash {
+		t.Fatalf("unexpected commitment %+v", commitments[0])
+	}
+
+	other := common.HexToAddress("0x4")
+	if n := len(p.ObservedCommitments(&other)); n != 0 {
+		t.Fatalf("expected no commitments of other provider, got %d", n)
+	}
+
+	providers := p.ObservedProviders()
+	if len(providers) != 1 || providers[0].Address != provider || providers[0].Commitments != 2 {
+		t.Fatalf("unexpected providers %+v", providers)
+	}
+}
+
+func bidsTopic(t *testing.T, topics []p2p.TopicDesc) p2p.TopicDesc {
+	t.Helper()
+
+	idx := slices.IndexFunc(topics, func(d p2p.TopicDesc) bool {
+		return d.Name == preconfirmation.BidsTopic
+	})
+	if idx == -1 {
+		t.Fatal("bids topic not found")
+	}
+	return topics[idx]
 }
@@ -5,7 +5,9 @@ import (
 	"encoding/hex"
 	"log/slog"
 	"math/big"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bufbuild/protovalidate-go"
@@ -25,8 +27,18 @@ type Service struct {
 	logger           *slog.Logger
 	metrics          *metrics
 	validator        *protovalidate.Validator
+	withdrawMu       sync.Mutex
+	commitmentsMu    sync.Mutex
+	// commitments maps the digests of the commitments received for the bids
+	// of the bidder to the time they were received.
+	commitments map[common.Hash]time.Time
 }
 
+// unstoredCommitmentTTL is how long a received commitment which is not in
+// the bidder registry is tracked, e.g. because the provider failed to store
+// it.
+const unstoredCommitmentTTL = time.Hour
+
 func NewService(
 	sender PreconfSender,
 	owner common.Address,
@@ -41,6 +53,7 @@ func NewService(
 		logger:           logger,
 		metrics:          newMetrics(),
 		validator:        validator,
+		commitments:      make(map[common.Hash]time.Time),
 	}
 }
 
@@ -80,6 +93,7 @@ func (s *Service) SendBid(
 	}
 
 	for resp := range respC {
+		s.trackCommitment(common.BytesToHash(resp.Digest))
 		b := resp.Bid
 		err := srv.Send(&bidderapiv1.Commitment{
 			TxHashes:             strings.Split(b.TxHash, ","),
@@ -153,3 +167,159 @@ func (s *Service) GetMinAllowance(
 
 	return &bidderapiv1.PrepayResponse{Amount: stakeAmount.String()}, nil
 }
+
+func (s *Service) WithdrawAllowance(
+	ctx context.Context,
+	_ *bidderapiv1.EmptyMessage,
+) (*bidderapiv1.WithdrawResponse, error) {
+	// Only one withdrawal at a time, the withdrawal of the whole allowance
+	// is pending until its transaction is confirmed even if an earlier call
+	// returned already.
+	if !s.withdrawMu.TryLock() {
+		return nil, status.Errorf(codes.Aborted, "withdrawal already in progress")
+	}
+	defer s.withdrawMu.Unlock()
+
+	pending, err := s.registryContract.IsWithdrawalPending(s.owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "checking pending withdrawal: %v", err)
+	}
+	if pending {
+		return nil, status.Errorf(codes.Aborted, "withdrawal already in progress")
+	}
+
+	amount, err := s.registryContract.GetAllowance(ctx, s.owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting allowance: %v", err)
+	}
+
+	if amount.Sign() == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no allowance to withdraw")
+	}
+
+	err = s.registryContract.WithdrawAllowance(ctx, s.owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "withdrawing allowance: %v", err)
+	}
+
+	return &bidderapiv1.WithdrawResponse{Amount: amount.String()}, nil
+}
+
+func (s *Service) GetBidderStatus(
+	ctx context.Context,
+	req *bidderapiv1.BidderStatusRequest,
+) (*bidderapiv1.BidderStatusResponse, error) {
+	err := s.validator.Validate(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validating status request: %v", err)
+	}
+
+	registered, err := s.registryContract.IsBidderRegistered(ctx, s.owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting registration: %v", err)
+	}
+
+	freeAmount, err := s.registryContract.GetAllowance(ctx, s.owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting allowance: %v", err)
+	}
+
+	minAllowance, err := s.registryContract.GetMinAllowance(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting min allowance: %v", err)
+	}
+
+	// The withdrawal transfers the whole free allowance once it is
+	// confirmed.
+	pendingWithdrawal := big.NewInt(0)
+	pending, err := s.registryContract.IsWithdrawalPending(s.owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "checking pending withdrawal: %v", err)
+	}
+	if pending {
+		pendingWithdrawal = freeAmount
+	}
+
+	digests := s.trackedCommitments()
+	for _, digest := range req.CommitmentDigests {
+		hash := common.HexToHash(digest)
+		if !slices.Contains(digests, hash) {
+			digests = append(digests, hash)
+		}
+	}
+
+	lockedAmount := big.NewInt(0)
+	lockedBids := make([]*bidderapiv1.LockedBid, 0, len(digests))
+	for _, digest := range digests {
+		payment, err := s.registryContract.GetBidPayment(ctx, digest)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "getting bid payment: %v", err)
+		}
+		s.updateCommitment(digest, payment.State)
+		// Funds are only locked while the commitment is not yet settled.
+		if payment.Bidder != s.owner || payment.State != registrycontract.BidStatePreConfirmed {
+			continue
+		}
+		lockedAmount.Add(lockedAmount, payment.Amount)
+		lockedBids = append(lockedBids, &bidderapiv1.LockedBid{
+			CommitmentDigest: hex.EncodeToString(digest.Bytes()),
+			Amount:           payment.Amount.String(),
+		})
+	}
+
+	return &bidderapiv1.BidderStatusResponse{
+		Registered:              registered,
+		FreeAmount:              freeAmount.String(),
+		LockedAmount:            lockedAmount.String(),
+		MinAllowance:            minAllowance.String(),
+		PendingWithdrawalAmount: pendingWithdrawal.String(),
+		LockedBids:              lockedBids,
+	}, nil
+}
+
+// trackCommitment records the digest of a commitment received for a bid of
+// the bidder, so that its locked funds are reported in the status.
+func (s *Service) trackCommitment(digest common.Hash) {
+	s.commitmentsMu.Lock()
+	defer s.commitmentsMu.Unlock()
+
+	if _, found := s.commitments[digest]; !found {
+		s.commitments[digest] = time.Now()
+	}
+}
+
+// trackedCommitments returns the digests of the tracked commitments in the
+// order they were received.
+func (s *Service) trackedCommitments() []common.Hash {
+	s.commitmentsMu.Lock()
+	defer s.commitmentsMu.Unlock()
+
+	digests := make([]common.Hash, 0, len(s.commitments))
+	for digest := range s.commitments {
+		digests = append(digests, digest)
+	}
+	slices.SortFunc(digests, func(a, b common.Hash) int {
+		return s.commitments[a].Compare(s.commitments[b])
+	})
+	return digests
+}
+
+// updateCommitment stops tracking the commitment once its funds are settled,
+// or if it didn't reach the bidder registry in time.
+func (s *Service) updateCommitment(digest common.Hash, state registrycontract.BidState) {
+	s.commitmentsMu.Lock()
+	defer s.commitmentsMu.Unlock()
+
+	received, found := s.commitments[digest]
+	if !found {
+		return
+	}
+	switch state {
+	case registrycontract.BidStateWithdrawn:
+		delete(s.commitments, digest)
+	case registrycontract.BidStateUndefined:
+		if time.Since(received) > unstoredCommitmentTTL {
+			delete(s.commitments, digest)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package bidderapi_test
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -15,10 +16,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	bidderapiv1 "github.com/primevprotocol/mev-commit/gen/go/bidderapi/v1"
 	preconfpb "github.com/primevprotocol/mev-commit/gen/go/preconfirmation/v1"
+	registrycontract "github.com/primevprotocol/mev-commit/pkg/contracts/bidder_registry"
 	bidderapi "github.com/primevprotocol/mev-commit/pkg/rpc/bidder"
 	"github.com/primevprotocol/mev-commit/pkg/util"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -75,8 +79,10 @@ func (s *testSender) SendBid(
 }
 
 type testRegistryContract struct {
-	allowance    *big.Int
-	minAllowance *big.Int
+	allowance         *big.Int
+	minAllowance      *big.Int
+	bidPayments       map[common.Hash]*registrycontract.BidPayment
+	pendingWithdrawal bool
 }
 
 func (t *testRegistryContract) PrepayAllowance(ctx context.Context, amount *big.Int) error {
@@ -96,7 +102,30 @@ func (t *testRegistryContract) CheckBidderAllowance(ctx context.Context, address
 	return t.allowance.Cmp(t.minAllowance) > 0
 }
 
-func startServer(t *testing.T) bidderapiv1.BidderClient {
+func (t *testRegistryContract) WithdrawAllowance(ctx context.Context, address common.Address) error {
+	t.allowance = big.NewInt(0)
+	return nil
+}
+
+func (t *testRegistryContract) IsWithdrawalPending(address common.Address) (bool, error) {
+	return t.pendingWithdrawal, nil
+}
+
+func (t *testRegistryContract) IsBidderRegistered(ctx context.Context, address common.Address) (bool, error) {
+	return t.allowance != nil, nil
+}
+
+func (t *testRegistryContract) GetBidPayment(
+	ctx context.Context,
+	commitmentDigest common.Hash,
+) (*registrycontract.BidPayment, error) {
+	if p, found := t.bidPayments[commitmentDigest]; found {
+		return p, nil
+	}
+	return &registrycontract.BidPayment{Amount: big.NewInt(0)}, nil
+}
+
+func startServer(t *testing.T) (bidderapiv1.BidderClient, *testRegistryContract) {
 	lis := bufconn.Listen(bufferSize)
 
 	logger := util.NewTestLogger(os.Stdout)
@@ -106,7 +135,21 @@ func startServer(t *testing.T) bidderapiv1.BidderClient {
 	}
 
 	owner := common.HexToAddress("0x00001")
-	registryContract := &testRegistryContract{minAllowance: big.NewInt(100000000000000000)}
+	registryContract := &testRegistryContract{
+		minAllowance: big.NewInt(100000000000000000),
+		bidPayments: map[common.Hash]*registrycontract.BidPayment{
+			common.HexToHash("0x01"): {
+				Bidder: owner,
+				Amount: big.NewInt(2000000000),
+				State:  registrycontract.BidStatePreConfirmed,
+			},
+			common.HexToHash("0x02"): {
+				Bidder: owner,
+				Amount: big.NewInt(3000000000),
+				State:  registrycontract.BidStateWithdrawn,
+			},
+		},
+	}
 	sender := &testSender{noOfPreconfs: 2}
 
 	srvImpl := bidderapi.NewService(
@@ -143,13 +186,13 @@ func startServer(t *testing.T) bidderapiv1.BidderClient {
 
 	client := bidderapiv1.NewBidderClient(conn)
 
-	return client
+	return client, registryContract
 }
 
 func TestAllowanceHandling(t *testing.T) {
 	t.Parallel()
 
-	client := startServer(t)
+	client, _ := startServer(t)
 
 	t.Run("prepay", func(t *testing.T) {
 		type testCase struct {
@@ -210,12 +253,65 @@ func TestAllowanceHandling(t *testing.T) {
 			t.Fatalf("expected amount to be 100000000000000000, got %v", allowance.Amount)
 		}
 	})
+
+	t.Run("get status", func(t *testing.T) {
+		_, err := client.GetBidderStatus(context.Background(), &bidderapiv1.BidderStatusRequest{
+			CommitmentDigests: []string{"asdf"},
+		})
+		if err == nil || !strings.Contains(err.Error(), "commitment_digests must be a valid array of commitment digests") {
+			t.Fatalf("expected error getting status, got %v", err)
+		}
+
+		st, err := client.GetBidderStatus(context.Background(), &bidderapiv1.BidderStatusRequest{
+			CommitmentDigests: []string{
+				common.HexToHash("0x01").Hex()[2:],
+				common.HexToHash("0x02").Hex()[2:],
+				common.HexToHash("0x03").Hex()[2:],
+			},
+		})
+		if err != nil {
+			t.Fatalf("error getting status: %v", err)
+		}
+		if !st.Registered {
+			t.Fatalf("expected bidder to be registered")
+		}
+		if st.FreeAmount != "1000000000000000000" {
+			t.Fatalf("expected free amount to be 1000000000000000000, got %v", st.FreeAmount)
+		}
+		if st.LockedAmount != "2000000000" {
+			t.Fatalf("expected locked amount to be 2000000000, got %v", st.LockedAmount)
+		}
+		if st.MinAllowance != "100000000000000000" {
+			t.Fatalf("expected min allowance to be 100000000000000000, got %v", st.MinAllowance)
+		}
+		if st.PendingWithdrawalAmount != "0" {
+			t.Fatalf("expected pending withdrawal amount to be 0, got %v", st.PendingWithdrawalAmount)
+		}
+		if len(st.LockedBids) != 1 || st.LockedBids[0].CommitmentDigest != common.HexToHash("0x01").Hex()[2:] {
+			t.Fatalf("expected 1 locked bid, got %v", st.LockedBids)
+		}
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		resp, err := client.WithdrawAllowance(context.Background(), &bidderapiv1.EmptyMessage{})
+		if err != nil {
+			t.Fatalf("error withdrawing allowance: %v", err)
+		}
+		if resp.Amount != "1000000000000000000" {
+			t.Fatalf("expected amount to be 1000000000000000000, got %v", resp.Amount)
+		}
+
+		_, err = client.WithdrawAllowance(context.Background(), &bidderapiv1.EmptyMessage{})
+		if err == nil || !strings.Contains(err.Error(), "no allowance to withdraw") {
+			t.Fatalf("expected error withdrawing allowance, got %v", err)
+		}
+	})
 }
 
 func TestSendBid(t *testing.T) {
 	t.Parallel()
 
-	client := startServer(t)
+	client, _ := startServer(t)
 
 	type testCase struct {
 		name                string
@@ -310,3 +406,81 @@ func TestSendBid(t *testing.T) {
 		})
 	}
 }
+
+func TestBidderStatus(t *testing.T) {
+	t.Parallel()
+
+	client, registryContract := startServer(t)
+
+	if _, err := client.PrepayAllowance(context.Background(), &bidderapiv1.PrepayRequest{Amount: "1000000000000000000"}); err != nil {
+		t.Fatalf("error prepaying allowance: %v", err)
+	}
+
+	// The commitments received for the bids of the bidder are tracked.
+	digest := common.BytesToHash([]byte("digest"))
+	registryContract.bidPayments[digest] = &registrycontract.BidPayment{
+		Bidder: common.HexToAddress("0x00001"),
+		Amount: big.NewInt(5000000000),
+		State:  registrycontract.BidStatePreConfirmed,
+	}
+	rcv, err := client.SendBid(context.Background(), &bidderapiv1.Bid{
+		TxHashes:            []string{common.HexToHash("0x0000ab").Hex()[2:]},
+		Amount:              "1000000000000000000",
+		BlockNumber:         1,
+		DecayStartTimestamp: 10,
+		DecayEndTimestamp:   20,
+	})
+	if err != nil {
+		t.Fatalf("error sending bid: %v", err)
+	}
+	for {
+		if _, err := rcv.Recv(); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			t.Fatalf("error receiving preconfs: %v", err)
+		}
+	}
+
+	st, err := client.GetBidderStatus(context.Background(), &bidderapiv1.BidderStatusRequest{
+		CommitmentDigests: []string{common.HexToHash("0x01").Hex()[2:]},
+	})
+	if err != nil {
+		t.Fatalf("error getting status: %v", err)
+	}
+	if st.LockedAmount != "7000000000" {
+		t.Fatalf("expected locked amount to be 7000000000, got %v", st.LockedAmount)
+	}
+	if len(st.LockedBids) != 2 || st.LockedBids[0].CommitmentDigest != hex.EncodeToString(digest.Bytes()) {
+		t.Fatalf("expected 2 locked bids, got %v", st.LockedBids)
+	}
+
+	// Settled commitments are not tracked anymore.
+	registryContract.bidPayments[digest].State = registrycontract.BidStateWithdrawn
+	if _, err := client.GetBidderStatus(context.Background(), &bidderapiv1.BidderStatusRequest{}); err != nil {
+		t.Fatalf("error getting status: %v", err)
+	}
+	registryContract.bidPayments[digest].State = registrycontract.BidStatePreConfirmed
+	st, err = client.GetBidderStatus(context.Background(), &bidderapiv1.BidderStatusRequest{})
+	if err != nil {
+		t.Fatalf("error getting status: %v", err)
+	}
+	if st.LockedAmount != "0" {
+		t.Fatalf("expected locked amount to be 0, got %v", st.LockedAmount)
+	}
+
+	// The free allowance is withdrawn once the pending withdrawal is
+	// confirmed.
+	registryContract.pendingWithdrawal = true
+	st, err = client.GetBidderStatus(context.Background(), &bidderapiv1.BidderStatusRequest{})
+	if err != nil {
+		t.Fatalf("error getting status: %v", err)
+	}
+	if st.PendingWithdrawalAmount != "1000000000000000000" {
+		t.Fatalf("expected pending withdrawal amount to be 1000000000000000000, got %v", st.PendingWithdrawalAmount)
+	}
+	_, err = client.WithdrawAllowance(context.Background(), &bidderapiv1.EmptyMessage{})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("expected withdrawal to be aborted, got %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+package providerapi
+
+func (s *Service) BidsInProcess() int {
+	s.bidsMu.Lock()
+	defer s.bidsMu.Unlock()
+	return len(s.bidsInProcess)
+}
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +17,10 @@ import (
 	providerapiv1 "github.com/primevprotocol/mev-commit/gen/go/providerapi/v1"
 	registrycontract "github.com/primevprotocol/mev-commit/pkg/contracts/provider_registry"
 	"github.com/primevprotocol/mev-commit/pkg/evmclient"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,7 +28,7 @@ import (
 type Service struct {
 	providerapiv1.UnimplementedProviderServer
 	receiver         chan *providerapiv1.Bid
-	bidsInProcess    map[string]func(providerapiv1.BidResponse_Status)
+	bidsInProcess    map[string]*bidInProcess
 	bidsMu           sync.Mutex
 	logger           *slog.Logger
 	owner            common.Address
@@ -33,6 +38,12 @@ type Service struct {
 	validator        *protovalidate.Validator
 }
 
+// bidInProcess is a bid sent to the provider client which waits for its
+// status.
+type bidInProcess struct {
+	callback func(providerapiv1.BidResponse_Status)
+}
+
 type EvmClient interface {
 	PendingTxns() []evmclient.TxnInfo
 	CancelTx(ctx context.Context, txHash common.Hash) (common.Hash, error)
@@ -47,7 +58,7 @@ func NewService(
 ) *Service {
 	return &Service{
 		receiver:         make(chan *providerapiv1.Bid),
-		bidsInProcess:    make(map[string]func(providerapiv1.BidResponse_Status)),
+		bidsInProcess:    make(map[string]*bidInProcess),
 		registryContract: registryContract,
 		owner:            owner,
 		logger:           logger,
@@ -76,30 +87,56 @@ func (s *Service) ProcessBid(
 		DecayStartTimestamp: bid.DecayStartTimestamp,
 		DecayEndTimestamp:   bid.DecayEndTimestamp,
 	}
+	// The provider client drops the bids the bidder stopped waiting for.
+	if deadline, ok := ctx.Deadline(); ok {
+		bidMsg.Deadline = deadline.UnixMilli()
+	}
+	// The provider client continues the trace of the bid.
+	bidMsg.TraceContext = make(map[string]string)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(bidMsg.TraceContext))
 
 	err := s.validator.Validate(bidMsg)
 	if err != nil {
 		return nil, err
 	}
 
+	span := trace.SpanFromContext(ctx)
+
 	respC := make(chan providerapiv1.BidResponse_Status, 1)
-	s.bidsMu.Lock()
-	s.bidsInProcess[string(bid.Digest)] = func(status providerapiv1.BidResponse_Status) {
-		respC <- status
-		close(respC)
+	entry := &bidInProcess{
+		callback: func(status providerapiv1.BidResponse_Status) {
+			// The channel is left open, the caller returns once the context
+			// is done.
+			if err := ctx.Err(); err != nil {
+				s.logger.Warn("dropping late bid status", "bidDigest", hex.EncodeToString(bid.Digest), "err", err)
+				return
+			}
+			span.AddEvent("received bid status", trace.WithAttributes(attribute.String("status", status.String())))
+			respC <- status
+			close(respC)
+		},
 	}
+	s.bidsMu.Lock()
+	s.bidsInProcess[string(bid.Digest)] = entry
 	s.bidsMu.Unlock()
 
-	select {
-	case <-ctx.Done():
+	// No status arrives for bids which are dropped or decided after the
+	// caller stopped waiting, the entry is removed once the context is done.
+	context.AfterFunc(ctx, func() {
 		s.bidsMu.Lock()
-		delete(s.bidsInProcess, string(bid.Digest))
+		if s.bidsInProcess[string(bid.Digest)] == entry {
+			delete(s.bidsInProcess, string(bid.Digest))
+		}
 		s.bidsMu.Unlock()
+	})
 
+	select {
+	case <-ctx.Done():
 		s.logger.Error("context cancelled for sending bid", "err", ctx.Err())
 		return nil, ctx.Err()
 	case s.receiver <- bidMsg:
 	}
+	span.AddEvent("sent bid to provider")
 	s.logger.Info("sent bid to provider node", "bid", bid)
 
 	return respC, nil
@@ -115,6 +152,13 @@ func (s *Service) ReceiveBids(
 			s.logger.Error("context cancelled for receiving bid", "err", srv.Context().Err())
 			return srv.Context().Err()
 		case bid := <-s.receiver:
+			if bid.Deadline > 0 && time.Now().UnixMilli() >= bid.Deadline {
+				s.logger.Warn("dropping expired bid", "bid", toString(bid))
+				s.bidsMu.Lock()
+				delete(s.bidsInProcess, string(bid.BidDigest))
+				s.bidsMu.Unlock()
+				continue
+			}
 			s.logger.Info("received bid from node", "bid", toString(bid))
 			err := srv.Send(bid)
 			if err != nil {
@@ -140,7 +184,7 @@ func (s *Service) SendProcessedBids(srv providerapiv1.Provider_SendProcessedBids
 		}
 
 		s.bidsMu.Lock()
-		callback, ok := s.bidsInProcess[string(status.BidDigest)]
+		inProcess, ok := s.bidsInProcess[string(status.BidDigest)]
 		delete(s.bidsInProcess, string(status.BidDigest))
 		s.bidsMu.Unlock()
 
@@ -150,7 +194,7 @@ func (s *Service) SendProcessedBids(srv providerapiv1.Provider_SendProcessedBids
 				"bidDigest", hex.EncodeToString(status.BidDigest),
 				"status", status.Status.String(),
 			)
-			callback(status.Status)
+			inProcess.callback(status.Status)
 			if status.Status == providerapiv1.BidResponse_STATUS_ACCEPTED {
 				s.metrics.BidsAcceptedByProviderCount.Inc()
 			} else {
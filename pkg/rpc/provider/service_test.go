@@ -15,7 +15,11 @@ import (
 	providerapiv1 "github.com/primevprotocol/mev-commit/gen/go/providerapi/v1"
 	"github.com/primevprotocol/mev-commit/pkg/evmclient"
 	providerapi "github.com/primevprotocol/mev-commit/pkg/rpc/provider"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
 	"github.com/primevprotocol/mev-commit/pkg/util"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
@@ -357,6 +361,81 @@ func TestBidHandling(t *testing.T) {
 	}
 }
 
+func TestBidDeadline(t *testing.T) {
+	t.Parallel()
+
+	client, svc := startServer(t, nil)
+
+	rcvr, err := client.ReceiveBids(context.Background(), &providerapiv1.EmptyMessage{})
+	if err != nil {
+		t.Fatalf("error receiving bids: %v", err)
+	}
+	bidC := make(chan *providerapiv1.Bid, 1)
+	go func() {
+		bid, err := rcvr.Recv()
+		if err != nil {
+			return
+		}
+		bidC <- bid
+	}()
+
+	deadline := time.Now().Add(time.Minute)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	respC, err := svc.ProcessBid(ctx, &preconfpb.Bid{
+		TxHash:              common.HexToHash("0x00001").Hex()[2:],
+		BidAmount:           "1000000000000000000",
+		BlockNumber:         1,
+		Digest:              []byte("digest"),
+		Signature:           []byte("signature"),
+		DecayStartTimestamp: 199,
+		DecayEndTimestamp:   299,
+	})
+	if err != nil {
+		t.Fatalf("error processing bid: %v", err)
+	}
+
+	var bid *providerapiv1.Bid
+	select {
+	case bid = <-bidC:
+		if bid.Deadline != deadline.UnixMilli() {
+			t.Fatalf("expected deadline %d, got %d", deadline.UnixMilli(), bid.Deadline)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for bid")
+	}
+
+	// The status of a bid the bidder stopped waiting for is dropped without
+	// closing the channel, the caller returns with the context error.
+	cancel()
+	start := time.Now()
+	for svc.BidsInProcess() != 0 {
+		if time.Since(start) > 2*time.Second {
+			t.Fatal("expected bid to be removed once the context is done")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	sndr, err := client.SendProcessedBids(context.Background())
+	if err != nil {
+		t.Fatalf("error sending processed bids: %v", err)
+	}
+	err = sndr.Send(&providerapiv1.BidResponse{
+		BidDigest: bid.BidDigest,
+		Status:    providerapiv1.BidResponse_STATUS_ACCEPTED,
+	})
+	if err != nil {
+		t.Fatalf("error sending bid status: %v", err)
+	}
+	// The server returns once it handled the status.
+	_, _ = sndr.CloseAndRecv()
+	select {
+	case st, ok := <-respC:
+		t.Fatalf("expected no status for late bid, got %v (open %t)", st, ok)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestCancelTx(t *testing.T) {
 	t.Parallel()
 
@@ -416,3 +495,61 @@ func TestCancelTx(t *testing.T) {
 		}
 	})
 }
+
+func TestBidTraceContext(t *testing.T) {
+	t.Parallel()
+
+	if _, err := tracing.New(tracing.Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	client, svc := startServer(t, nil)
+
+	rcvr, err := client.ReceiveBids(context.Background(), &providerapiv1.EmptyMessage{})
+	if err != nil {
+		t.Fatalf("error receiving bids: %v", err)
+	}
+	bidC := make(chan *providerapiv1.Bid, 1)
+	go func() {
+		bid, err := rcvr.Recv()
+		if err != nil {
+			return
+		}
+		bidC <- bid
+	}()
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx, cancel := context.WithTimeout(trace.ContextWithSpanContext(context.Background(), sc), time.Minute)
+	defer cancel()
+
+	_, err = svc.ProcessBid(ctx, &preconfpb.Bid{
+		TxHash:              common.HexToHash("0x00001").Hex()[2:],
+		BidAmount:           "1000000000000000000",
+		BlockNumber:         1,
+		Digest:              []byte("trace"),
+		Signature:           []byte("signature"),
+		DecayStartTimestamp: 199,
+		DecayEndTimestamp:   299,
+	})
+	if err != nil {
+		t.Fatalf("error processing bid: %v", err)
+	}
+
+	select {
+	case bid := <-bidC:
+		// The provider client continues the trace of the bid.
+		remote := trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(
+			context.Background(),
+			propagation.MapCarrier(bid.TraceContext),
+		))
+		if remote.TraceID() != sc.TraceID() || remote.SpanID() != sc.SpanID() {
+			t.Fatalf("expected span context %v, got %v", sc, remote)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for bid")
+	}
+}
@@ -0,0 +1,11 @@
+package topology
+
+import "time"
+
+func (t *Topology) SetNow(now func() time.Time) {
+	t.now = now
+}
+
+func (t *Topology) Maintain() {
+	t.maintain()
+}
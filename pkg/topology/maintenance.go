@@ -0,0 +1,300 @@
+package topology
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+)
+
+const (
+	maintainInterval = 10 * time.Second
+	dialTimeout      = 30 * time.Second
+	// initialBackoff is the delay before the first redial of a disconnected
+	// peer. It doubles with every failed attempt up to maxBackoff.
+	initialBackoff = 5 * time.Second
+	maxBackoff     = 10 * time.Minute
+	// redialWindow is the time after the disconnect during which a peer is
+	// redialed.
+	redialWindow = time.Hour
+	// peerSyncInterval is the minimum time between the requests for peers
+	// from the connected peers while the node is below its target.
+	peerSyncInterval = time.Minute
+)
+
+// Dialer connects to peers.
+type Dialer interface {
+	Connect(ctx context.Context, underlay []byte) (p2p.Peer, error)
+}
+
+// AddressBook lists the peers of a type which were connected before and are
+// not connected now.
+type AddressBook interface {
+	KnownPeersOf(peerType p2p.PeerType) []p2p.PeerInfo
+}
+
+// PeerSyncer requests the peers known to the connected peers and connects to
+// the new ones.
+type PeerSyncer interface {
+	Resync()
+}
+
+// Targets are the minimum numbers of connected peers which the topology
+// tries to keep. A target of zero disables the maintenance for the type.
+type Targets struct {
+	Providers int
+	Bidders   int
+}
+
+func (t Targets) of(peerType p2p.PeerType) int {
+	switch peerType {
+	case p2p.PeerTypeProvider:
+		return t.Providers
+	case p2p.PeerTypeBidder:
+		return t.Bidders
+	default:
+		return 0
+	}
+}
+
+// redial is a recently disconnected peer.
+type redial struct {
+	peer           p2p.Peer
+	underlay       []byte
+	attempts       int
+	next           time.Time
+	disconnectedAt time.Time
+}
+
+func backoff(attempts int) time.Duration {
+	d := initialBackoff
+	for i := 0; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
+
+func (t *Topology) SetDialer(d Dialer) {
+	t.dialer = d
+}
+
+// SetAddressBook sets the address book which is dialed if no disconnected
+// peers are due.
+func (t *Topology) SetAddressBook(b AddressBook) {
+	t.book = b
+}
+
+// SetPeerSyncer sets the syncer which is asked for new peers if neither
+// disconnected nor known peers are left to dial.
+func (t *Topology) SetPeerSyncer(s PeerSyncer) {
+	t.syncer = s
+}
+
+// SetTargets sets the minimum numbers of connected peers.
+func (t *Topology) SetTargets(targets Targets) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.targets = targets
+}
+
+// Start starts the loop which redials recently disconnected peers, or dials
+// the known peers, while the node is below its target number of peers.
+func (t *Topology) Start() {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+
+		ticker := time.NewTicker(maintainInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-t.quit:
+				return
+			case <-ticker.C:
+				t.maintain()
+			}
+		}
+	}()
+}
+
+func (t *Topology) Close() error {
+	close(t.quit)
+	t.wg.Wait()
+	return nil
+}
+
+// addRedial remembers a disconnected peer so that it can be redialed.
+// Must be called with the lock held.
+func (t *Topology) addRedial(p p2p.Peer) {
+	if t.targets.of(p.Type) == 0 || p.Record == nil || len(p.Record.Underlay) == 0 {
+		return
+	}
+	now := t.now()
+	t.redials[p.EthAddress] = &redial{
+		peer:           p,
+		underlay:       p.Record.Underlay,
+		next:           now.Add(initialBackoff),
+		disconnectedAt: now,
+	}
+}
+
+// maintain checks the number of connected peers of each type against its
+// target and redials the disconnected peers which are due. If none are due,
+// the peers from the address book are dialed and, if none are left either,
+// the connected peers are asked for new ones.
+func (t *Topology) maintain() {
+	if t.dialer == nil {
+		return
+	}
+
+	var (
+		dials  []*redial
+		resync bool
+	)
+	for _, peerType := range []p2p.PeerType{p2p.PeerTypeProvider, p2p.PeerTypeBidder} {
+		due, missing := t.checkTarget(peerType)
+		dials = append(dials, due...)
+		if len(due) > 0 || missing == 0 {
+			continue
+		}
+		// The address book is read without the lock as the p2p service
+		// notifies the topology with its own locks held.
+		var known []p2p.PeerInfo
+		if t.book != nil {
+			known = t.book.KnownPeersOf(peerType)
+		}
+		due = t.dialKnown(peerType, known, missing)
+		dials = append(dials, due...)
+		if len(due) == 0 {
+			resync = true
+		}
+	}
+
+	if resync && t.syncer != nil && t.syncDue() {
+		t.logger.Info("requesting peers from the connected peers")
+		t.syncer.Resync()
+	}
+
+	var wg sync.WaitGroup
+	for _, r := range dials {
+		wg.Add(1)
+		go func(r *redial) {
+			defer wg.Done()
+			t.redial(r)
+		}(r)
+	}
+	wg.Wait()
+}
+
+// checkTarget updates the alert state of the peer type and returns the
+// peers to redial together with the number of peers missing to the target.
+func (t *Topology) checkTarget(peerType p2p.PeerType) ([]*redial, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	for addr, r := range t.redials {
+		if now.Sub(r.disconnectedAt) > redialWindow {
+			delete(t.redials, addr)
+		}
+	}
+
+	target := t.targets.of(peerType)
+	connected := len(t.bucket(peerType))
+	below := connected < target
+	label := peerType.String()
+
+	switch {
+	case below && !t.belowTarget[peerType]:
+		t.logger.Warn("connected peers below target", "type", label, "connected", connected, "target", target)
+		t.metrics.BelowTarget.WithLabelValues(label).Set(1)
+	case !below && t.belowTarget[peerType]:
+		t.logger.Info("connected peers back at target", "type", label, "connected", connected, "target", target)
+		t.metrics.BelowTarget.WithLabelValues(label).Set(0)
+	}
+	t.belowTarget[peerType] = below
+	if !below {
+		return nil, 0
+	}
+
+	var due []*redial
+	for _, r := range t.redials {
+		if len(due) == target-connected {
+			break
+		}
+		if r.peer.Type != peerType || now.Before(r.next) {
+			continue
+		}
+		// The next attempt is scheduled before dialing so that a slow dial
+		// is not started again by the next round.
+		r.attempts++
+		r.next = now.Add(backoff(r.attempts))
+		due = append(due, r)
+	}
+	return due, target - connected
+}
+
+// dialKnown returns up to limit peers from the address book to dial. They are
+// redialed with the backoff of disconnected peers if the dial fails, and are
+// only taken from the address book again after the redial window.
+func (t *Topology) dialKnown(peerType p2p.PeerType, known []p2p.PeerInfo, limit int) []*redial {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	var due []*redial
+	for _, info := range known {
+		if len(due) == limit {
+			break
+		}
+		if _, found := t.redials[info.EthAddress]; found || len(info.Underlay) == 0 {
+			continue
+		}
+		if _, connected := t.bucket(peerType)[info.EthAddress]; connected {
+			continue
+		}
+		r := &redial{
+			peer:           p2p.Peer{EthAddress: info.EthAddress, Type: peerType},
+			underlay:       info.Underlay,
+			attempts:       1,
+			next:           now.Add(backoff(1)),
+			disconnectedAt: now,
+		}
+		t.redials[info.EthAddress] = r
+		due = append(due, r)
+	}
+	return due
+}
+
+// syncDue reports whether the connected peers can be asked for peers again.
+func (t *Topology) syncDue() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	if !t.lastSync.IsZero() && now.Sub(t.lastSync) < peerSyncInterval {
+		return false
+	}
+	t.lastSync = now
+	return true
+}
+
+func (t *Topology) redial(r *redial) {
+	t.metrics.RedialsCount.Inc()
+
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+
+	p, err := t.dialer.Connect(ctx, r.underlay)
+	if err != nil {
+		t.metrics.FailedRedialsCount.Inc()
+		t.logger.Debug("failed to redial peer", "peer", r.peer, "attempts", r.attempts, "err", err)
+		return
+	}
+	// The p2p service only notifies the topology of inbound connections.
+	t.AddPeers(p)
+	t.logger.Info("redialed peer", "peer", p)
+}
@@ -10,6 +10,10 @@ const (
 type metrics struct {
 	ConnectedBiddersCount   prometheus.Gauge
 	ConnectedProvidersCount prometheus.Gauge
+	ConnectedObserversCount prometheus.Gauge
+	BelowTarget             *prometheus.GaugeVec
+	RedialsCount            prometheus.Counter
+	FailedRedialsCount      prometheus.Counter
 }
 
 func newMetrics() *metrics {
@@ -26,6 +30,30 @@ func newMetrics() *metrics {
 			Name:      "connected_providers_count",
 			Help:      "Number of connected providers",
 		}),
+		ConnectedObserversCount: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "connected_observers_count",
+			Help:      "Number of connected observers",
+		}),
+		BelowTarget: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "below_target",
+			Help:      "Set to 1 if the number of connected peers of the type is below the target",
+		}, []string{"type"}),
+		RedialsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "redials_count",
+			Help:      "Number of redials of disconnected peers",
+		}),
+		FailedRedialsCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "failed_redials_count",
+			Help:      "Number of failed redials of disconnected peers",
+		}),
 	}
 }
 
@@ -33,5 +61,9 @@ func (t *Topology) Metrics() []prometheus.Collector {
 	return []prometheus.Collector{
 		t.metrics.ConnectedBiddersCount,
 		t.metrics.ConnectedProvidersCount,
+		t.metrics.ConnectedObserversCount,
+		t.metrics.BelowTarget,
+		t.metrics.RedialsCount,
+		t.metrics.FailedRedialsCount,
 	}
 }
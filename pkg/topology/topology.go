@@ -2,38 +2,195 @@ package topology
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"math/big"
+	"slices"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
 )
 
+// SortKey orders the result of a query.
+type SortKey int
+
+const (
+	// SortNone keeps the peers in random order.
+	SortNone SortKey = iota
+	// SortByScore orders the peers by their reputation score, best first.
+	SortByScore
+	// SortByStake orders the peers by their stake, highest first.
+	SortByStake
+	// SortByLatency orders the peers by their latency, lowest first. Peers
+	// without a measured latency come last.
+	SortByLatency
+	// SortByConnectionAge orders the peers by the time they are connected,
+	// longest first.
+	SortByConnectionAge
+)
+
+// ParseSortKey parses the name of a sort key as used by the debug API.
+func ParseSortKey(s string) (SortKey, error) {
+	switch s {
+	case "":
+		return SortNone, nil
+	case "score":
+		return SortByScore, nil
+	case "stake":
+		return SortByStake, nil
+	case "latency":
+		return SortByLatency, nil
+	case "connection_age":
+		return SortByConnectionAge, nil
+	default:
+		return SortNone, fmt.Errorf("invalid sort key %q", s)
+	}
+}
+
 type Query struct {
 	Type p2p.PeerType
+	// Protocol only selects peers which accept streams of the protocol.
+	// Peers with unknown capabilities are assumed to support it.
+	Protocol p2p.ProtocolInfo
+	// Feature only selects peers which advertised the feature.
+	Feature string
+	// MinScore only selects peers with at least the given reputation score
+	// if it is set.
+	MinScore *float64
+	// MinStake only selects peers with at least the given stake if it is
+	// set. Peers with an unknown stake are not selected.
+	MinStake *big.Int
+	// MaxLatency only selects peers with a measured latency of at most the
+	// given duration if it is set. Peers without a measured latency are
+	// selected.
+	MaxLatency time.Duration
+	// MinVersion only selects peers which run at least the given version of
+	// the node software if it is set.
+	MinVersion string
+	// MinConnectionAge only selects peers which are connected for at least
+	// the given duration.
+	MinConnectionAge time.Duration
+	// Tags only selects peers which were assigned all the tags.
+	Tags []string
+	// SortBy orders the peers.
+	SortBy SortKey
+	// Limit is the maximum number of peers returned if it is set.
+	Limit int
+}
+
+func (q Query) matches(p p2p.Peer) bool {
+	caps := p.Capabilities
+	if q.Feature != "" && (caps == nil || !caps.HasFeature(q.Feature)) {
+		return false
+	}
+	if q.Protocol.Name != "" && caps != nil &&
+		!caps.SupportsProtocol(q.Protocol.Name, q.Protocol.Version) {
+		return false
+	}
+	if q.MinVersion != "" && (caps == nil || !atLeastVersion(caps.Version, q.MinVersion)) {
+		return false
+	}
+	return true
+}
+
+func atLeastVersion(version, minVersion string) bool {
+	have, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	want, err := semver.NewVersion(minVersion)
+	if err != nil {
+		return false
+	}
+	return !have.LessThan(want)
+}
+
+// PeerDetails describes a connected peer.
+type PeerDetails struct {
+	Peer p2p.Peer `json:"peer"`
+	// Score is the reputation score of the peer.
+	Score float64 `json:"score"`
+	// Stake of a provider when it connected, nil if it is unknown.
+	Stake *big.Int `json:"stake,omitempty"`
+	// Latency is the measured round trip time, zero if it is unknown.
+	Latency     time.Duration `json:"latency"`
+	ConnectedAt time.Time     `json:"connected_at"`
+	Tags        []string      `json:"tags,omitempty"`
+}
+
+// StakeReader provides the stake of providers.
+type StakeReader interface {
+	GetStake(context.Context, common.Address) (*big.Int, error)
+}
+
+// LatencyMeter provides the measured latency of connected peers.
+type LatencyMeter interface {
+	Latency(common.Address) time.Duration
+}
+
+// Scorer provides the reputation scores of the peers.
+type Scorer interface {
+	Score(common.Address) float64
 }
 
 type Announcer interface {
 	BroadcastPeers(context.Context, p2p.Peer, []p2p.PeerInfo) error
+	// Disconnected is called when a peer disconnects so that it is sent all
+	// peers again when it reconnects.
+	Disconnected(p2p.Peer)
+}
+
+// stakeTimeout is the deadline for reading the stake of a provider.
+const stakeTimeout = 5 * time.Second
+
+type entry struct {
+	peer        p2p.Peer
+	stake       *big.Int
+	connectedAt time.Time
 }
 
 type Topology struct {
 	mu          sync.RWMutex
-	providers   map[common.Address]p2p.Peer
-	bidders     map[common.Address]p2p.Peer
+	providers   map[common.Address]*entry
+	bidders     map[common.Address]*entry
+	observers   map[common.Address]*entry
+	tags        map[common.Address][]string
 	logger      *slog.Logger
 	addressbook p2p.Addressbook
 	announcer   Announcer
+	scorer      Scorer
+	stakes      StakeReader
+	latency     LatencyMeter
+	dialer      Dialer
+	book        AddressBook
+	syncer      PeerSyncer
+	lastSync    time.Time
+	targets     Targets
+	redials     map[common.Address]*redial
+	belowTarget map[p2p.PeerType]bool
 	metrics     *metrics
+	now         func() time.Time
+	quit        chan struct{}
+	wg          sync.WaitGroup
 }
 
 func New(a p2p.Addressbook, logger *slog.Logger) *Topology {
 	return &Topology{
-		providers:   make(map[common.Address]p2p.Peer),
-		bidders:     make(map[common.Address]p2p.Peer),
+		providers:   make(map[common.Address]*entry),
+		bidders:     make(map[common.Address]*entry),
+		observers:   make(map[common.Address]*entry),
+		tags:        make(map[common.Address][]string),
+		redials:     make(map[common.Address]*redial),
+		belowTarget: make(map[p2p.PeerType]bool),
 		addressbook: a,
 		logger:      logger,
 		metrics:     newMetrics(),
+		now:         time.Now,
+		quit:        make(chan struct{}),
 	}
 }
 
@@ -41,28 +198,68 @@ func (t *Topology) SetAnnouncer(a Announcer) {
 	t.announcer = a
 }
 
+func (t *Topology) SetScorer(s Scorer) {
+	t.scorer = s
+}
+
+func (t *Topology) SetStakeReader(r StakeReader) {
+	t.stakes = r
+}
+
+func (t *Topology) SetLatencyMeter(m LatencyMeter) {
+	t.latency = m
+}
+
+// SetTags assigns the tags to the peer, replacing the previous ones. Tags
+// are kept when the peer disconnects.
+func (t *Topology) SetTags(addr common.Address, tags []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(tags) == 0 {
+		delete(t.tags, addr)
+		return
+	}
+	t.tags[addr] = slices.Clone(tags)
+}
+
+func (t *Topology) latencyOf(p p2p.Peer) time.Duration {
+	if t.latency == nil {
+		return 0
+	}
+	return t.latency.Latency(p.EthAddress)
+}
+
+// stakeOf returns the stake of a provider or nil if it is unknown.
+func (t *Topology) stakeOf(p p2p.Peer) *big.Int {
+	if t.stakes == nil || p.Type != p2p.PeerTypeProvider {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), stakeTimeout)
+	defer cancel()
+
+	stake, err := t.stakes.GetStake(ctx, p.EthAddress)
+	if err != nil {
+		t.logger.Warn("failed to get stake", "err", err, "peer", p)
+		return nil
+	}
+	return stake
+}
+
+func (t *Topology) score(p p2p.Peer) float64 {
+	if t.scorer == nil {
+		return 0
+	}
+	return t.scorer.Score(p.EthAddress)
+}
+
 func (t *Topology) Connected(p p2p.Peer) {
 	t.add(p)
 
 	if t.announcer != nil {
 		// Whether its a provider or bidder, we want to broadcast the provider peers
-		peersToBroadcast := t.GetPeers(Query{Type: p2p.PeerTypeProvider})
-		var underlays []p2p.PeerInfo
-		for _, peer := range peersToBroadcast {
-			if peer.EthAddress == p.EthAddress {
-				continue
-			}
-			u, err := t.addressbook.GetPeerInfo(peer)
-			if err != nil {
-				t.logger.Error("failed to get peer info", "err", err, "peer", peer)
-				continue
-			}
-			underlays = append(underlays, p2p.PeerInfo{
-				EthAddress: peer.EthAddress,
-				Underlay:   u,
-			})
-		}
-
+		underlays := t.PeerInfos(p2p.PeerTypeProvider, p.EthAddress)
 		if len(underlays) > 0 {
 			err := t.announcer.BroadcastPeers(context.Background(), p, underlays)
 			if err != nil {
@@ -71,21 +268,20 @@ func (t *Topology) Connected(p p2p.Peer) {
 		}
 
 		if p.Type == p2p.PeerTypeProvider {
-			t.logger.Info("provider connected broadcasting to previous bidders", "peer", p)
-			// If the peer is a provider, we want to broadcast to the bidder peers
-			peersToBroadcastTo := t.GetPeers(Query{Type: p2p.PeerTypeBidder})
-			providerUnderlay, err := t.addressbook.GetPeerInfo(p)
+			t.logger.Info("provider connected broadcasting to previous bidders and observers", "peer", p)
+			// If the peer is a provider, we want to broadcast to the bidder and
+			// observer peers
+			peersToBroadcastTo := append(
+				t.GetPeers(Query{Type: p2p.PeerTypeBidder}),
+				t.GetPeers(Query{Type: p2p.PeerTypeObserver})...,
+			)
+			providerInfo, err := t.addressbook.GetPeerInfo(p)
 			if err != nil {
 				t.logger.Error("failed to get peer info", "err", err, "peer", p)
 				return
 			}
 			for _, peer := range peersToBroadcastTo {
-				err := t.announcer.BroadcastPeers(context.Background(), peer, []p2p.PeerInfo{
-					{
-						EthAddress: p.EthAddress,
-						Underlay:   providerUnderlay,
-					},
-				})
+				err := t.announcer.BroadcastPeers(context.Background(), peer, []p2p.PeerInfo{providerInfo})
 				if err != nil {
 					t.logger.Error("failed to broadcast peer", "err", err, "peer", peer)
 				}
@@ -94,21 +290,91 @@ func (t *Topology) Connected(p p2p.Peer) {
 	}
 }
 
+// PeerInfos returns the peer records of the connected peers of the given type
+// except the excluded peer.
+func (t *Topology) PeerInfos(peerType p2p.PeerType, exclude common.Address) []p2p.PeerInfo {
+	var res []p2p.PeerInfo
+	for _, peer := range t.GetPeers(Query{Type: peerType}) {
+		if peer.EthAddress == exclude {
+			continue
+		}
+		info, err := t.addressbook.GetPeerInfo(peer)
+		if err != nil {
+			t.logger.Error("failed to get peer info", "err", err, "peer", peer)
+			continue
+		}
+		res = append(res, info)
+	}
+	return res
+}
+
 func (t *Topology) add(p p2p.Peer) {
+	e := &entry{
+		peer:        p,
+		connectedAt: t.now(),
+	}
+
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	delete(t.redials, p.EthAddress)
+
+	// Peers which are added again keep their entry, so they are counted once
+	// and their stake is only read when they connect.
+	if prev, found := t.bucket(p.Type)[p.EthAddress]; found {
+		prev.peer = p
+		return
+	}
+	if prev, found := t.providers[p.EthAddress]; found {
+		e.connectedAt = prev.connectedAt
+	} else if prev, found := t.bidders[p.EthAddress]; found {
+		e.connectedAt = prev.connectedAt
+	} else if prev, found := t.observers[p.EthAddress]; found {
+		e.connectedAt = prev.connectedAt
+	}
+
 	switch p.Type {
 	case p2p.PeerTypeProvider:
-		t.providers[p.EthAddress] = p
+		t.providers[p.EthAddress] = e
 		t.metrics.ConnectedProvidersCount.Inc()
+		// The stake is read in the background as it may take a while.
+		if t.stakes != nil {
+			go t.updateStake(e, p)
+		}
 	case p2p.PeerTypeBidder:
-		t.bidders[p.EthAddress] = p
+		t.bidders[p.EthAddress] = e
 		t.metrics.ConnectedBiddersCount.Inc()
+	case p2p.PeerTypeObserver:
+		t.observers[p.EthAddress] = e
+		t.metrics.ConnectedObserversCount.Inc()
+	}
+}
+
+// updateStake reads the stake of a provider and sets it if the entry wasn't
+// removed or replaced meanwhile.
+func (t *Topology) updateStake(e *entry, p p2p.Peer) {
+	stake := t.stakeOf(p)
+	if stake == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.providers[p.EthAddress] == e {
+		e.stake = stake
 	}
 }
 
 func (t *Topology) Disconnected(p p2p.Peer) {
+	t.remove(p)
+
+	if t.announcer != nil {
+		t.announcer.Disconnected(p)
+	}
+}
+
+func (t *Topology) remove(p p2p.Peer) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
@@ -121,7 +387,11 @@ func (t *Topology) Disconnected(p p2p.Peer) {
 	case p2p.PeerTypeBidder:
 		delete(t.bidders, p.EthAddress)
 		t.metrics.ConnectedBiddersCount.Dec()
+	case p2p.PeerTypeObserver:
+		delete(t.observers, p.EthAddress)
+		t.metrics.ConnectedObserversCount.Dec()
 	}
+	t.addRedial(p)
 }
 
 func (t *Topology) AddPeers(peers ...p2p.Peer) {
@@ -131,23 +401,117 @@ func (t *Topology) AddPeers(peers ...p2p.Peer) {
 }
 
 func (t *Topology) GetPeers(q Query) []p2p.Peer {
+	details := t.PeerDetails(q)
+	peers := make([]p2p.Peer, 0, len(details))
+	for _, d := range details {
+		peers = append(peers, d.Peer)
+	}
+	return peers
+}
+
+// PeerDetails returns the details of the connected peers which match the
+// query.
+func (t *Topology) PeerDetails(q Query) []PeerDetails {
+	now := t.now()
+
 	t.mu.RLock()
-	defer t.mu.RUnlock()
+	var candidates []PeerDetails
+	for _, e := range t.bucket(q.Type) {
+		if !q.matches(e.peer) {
+			continue
+		}
+		if now.Sub(e.connectedAt) < q.MinConnectionAge {
+			continue
+		}
+		if q.MinStake != nil && (e.stake == nil || e.stake.Cmp(q.MinStake) < 0) {
+			continue
+		}
+		tags := t.tags[e.peer.EthAddress]
+		if !containsAll(tags, q.Tags) {
+			continue
+		}
+		candidates = append(candidates, PeerDetails{
+			Peer:        e.peer,
+			Stake:       e.stake,
+			ConnectedAt: e.connectedAt,
+			Tags:        tags,
+		})
+	}
+	t.mu.RUnlock()
 
-	var peers []p2p.Peer
+	// The scorer and the latency meter are called without holding the lock
+	// as the p2p service notifies the topology while holding its own.
+	res := candidates[:0]
+	for _, d := range candidates {
+		d.Score = t.score(d.Peer)
+		d.Latency = t.latencyOf(d.Peer)
+		if q.MinScore != nil && d.Score < *q.MinScore {
+			continue
+		}
+		if q.MaxLatency > 0 && d.Latency > q.MaxLatency {
+			continue
+		}
+		res = append(res, d)
+	}
 
-	switch q.Type {
+	sortDetails(res, q.SortBy)
+	if q.Limit > 0 && len(res) > q.Limit {
+		res = res[:q.Limit]
+	}
+	return res
+}
+
+// bucket returns the connected peers of the type. Must be called with the
+// lock held.
+func (t *Topology) bucket(peerType p2p.PeerType) map[common.Address]*entry {
+	switch peerType {
 	case p2p.PeerTypeProvider:
-		for _, p := range t.providers {
-			peers = append(peers, p)
-		}
+		return t.providers
 	case p2p.PeerTypeBidder:
-		for _, p := range t.bidders {
-			peers = append(peers, p)
+		return t.bidders
+	case p2p.PeerTypeObserver:
+		return t.observers
+	default:
+		return nil
+	}
+}
+
+func containsAll(tags, want []string) bool {
+	for _, w := range want {
+		if !slices.Contains(tags, w) {
+			return false
 		}
 	}
+	return true
+}
 
-	return peers
+func sortDetails(details []PeerDetails, key SortKey) {
+	var less func(a, b PeerDetails) bool
+	switch key {
+	case SortByScore:
+		less = func(a, b PeerDetails) bool { return a.Score > b.Score }
+	case SortByStake:
+		less = func(a, b PeerDetails) bool {
+			if a.Stake == nil || b.Stake == nil {
+				return b.Stake == nil && a.Stake != nil
+			}
+			return a.Stake.Cmp(b.Stake) > 0
+		}
+	case SortByLatency:
+		less = func(a, b PeerDetails) bool {
+			if a.Latency == 0 || b.Latency == 0 {
+				return b.Latency == 0 && a.Latency != 0
+			}
+			return a.Latency < b.Latency
+		}
+	case SortByConnectionAge:
+		less = func(a, b PeerDetails) bool { return a.ConnectedAt.Before(b.ConnectedAt) }
+	default:
+		return
+	}
+	sort.SliceStable(details, func(i, j int) bool {
+		return less(details[i], details[j])
+	})
 }
 
 func (t *Topology) IsConnected(addr common.Address) bool {
@@ -162,5 +526,9 @@ func (t *Topology) IsConnected(addr common.Address) bool {
 		return true
 	}
 
+	if _, ok := t.observers[addr]; ok {
+		return true
+	}
+
 	return false
 }
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"math/big"
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/primevprotocol/mev-commit/pkg/p2p"
@@ -16,13 +18,21 @@ import (
 
 type testAddressbook struct{}
 
-func (t *testAddressbook) GetPeerInfo(p p2p.Peer) ([]byte, error) {
-	return []byte("test"), nil
+func (t *testAddressbook) GetPeerInfo(p p2p.Peer) (p2p.PeerInfo, error) {
+	return p2p.PeerInfo{EthAddress: p.EthAddress, Underlay: []byte("test")}, nil
 }
 
 type announcer struct {
-	mu         sync.Mutex
-	broadcasts []p2p.Peer
+	mu           sync.Mutex
+	broadcasts   []p2p.Peer
+	disconnected []p2p.Peer
+}
+
+func (a *announcer) Disconnected(p p2p.Peer) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.disconnected = append(a.disconnected, p)
 }
 
 func (a *announcer) BroadcastPeers(_ context.Context, p p2p.Peer, peers []p2p.PeerInfo) error {
@@ -49,6 +59,92 @@ func newTestLogger(w io.Writer) *slog.Logger {
 	return slog.New(testLogger)
 }
 
+type testScorer map[common.Address]float64
+
+func (s testScorer) Score(addr common.Address) float64 {
+	return s[addr]
+}
+
+type testStakes map[common.Address]*big.Int
+
+func (s testStakes) GetStake(_ context.Context, addr common.Address) (*big.Int, error) {
+	stake, ok := s[addr]
+	if !ok {
+		return nil, errors.New("unknown provider")
+	}
+	return stake, nil
+}
+
+type stakeReaderFunc func(context.Context, common.Address) (*big.Int, error)
+
+func (f stakeReaderFunc) GetStake(ctx context.Context, addr common.Address) (*big.Int, error) {
+	return f(ctx, addr)
+}
+
+type testLatency map[common.Address]time.Duration
+
+func (l testLatency) Latency(addr common.Address) time.Duration {
+	return l[addr]
+}
+
+type testDialer struct {
+	mu    sync.Mutex
+	dials [][]byte
+	peer  p2p.Peer
+	err   error
+}
+
+func (d *testDialer) Connect(_ context.Context, underlay []byte) (p2p.Peer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.dials = append(d.dials, underlay)
+	if d.err != nil {
+		return p2p.Peer{}, d.err
+	}
+	return d.peer, nil
+}
+
+func (d *testDialer) succeed(p p2p.Peer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.peer = p
+	d.err = nil
+}
+
+func (d *testDialer) count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.dials)
+}
+
+type testBook map[p2p.PeerType][]p2p.PeerInfo
+
+func (b testBook) KnownPeersOf(peerType p2p.PeerType) []p2p.PeerInfo {
+	return b[peerType]
+}
+
+type testSyncer struct {
+	mu      sync.Mutex
+	resyncs int
+}
+
+func (s *testSyncer) Resync() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resyncs++
+}
+
+func (s *testSyncer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.resyncs
+}
+
 func TestTopology(t *testing.T) {
 	t.Parallel()
 
@@ -124,5 +220,456 @@ func TestTopology(t *testing.T) {
 		if topo.IsConnected(p1.EthAddress) {
 			t.Fatal("peer still connected")
 		}
+		if len(announcer.disconnected) != 1 || announcer.disconnected[0] != p1 {
+			t.Fatalf("expected announcer to be notified, got %v", announcer.disconnected)
+		}
+	})
+
+	t.Run("observer", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+		announcer := &announcer{}
+		topo.SetAnnouncer(announcer)
+
+		p1 := p2p.Peer{EthAddress: common.HexToAddress("0x1"), Type: p2p.PeerTypeProvider}
+		o1 := p2p.Peer{EthAddress: common.HexToAddress("0x2"), Type: p2p.PeerTypeObserver}
+		p2 := p2p.Peer{EthAddress: common.HexToAddress("0x3"), Type: p2p.PeerTypeProvider}
+
+		topo.Connected(p1)
+		topo.Connected(o1)
+
+		// The observer receives the connected provider.
+		if len(announcer.broadcasts) != 1 || announcer.broadcasts[0] != o1 {
+			t.Fatalf("expected broadcast to observer, got %v", announcer.broadcasts)
+		}
+
+		observers := topo.GetPeers(topology.Query{Type: p2p.PeerTypeObserver})
+		if len(observers) != 1 || observers[0] != o1 {
+			t.Fatalf("expected observer %v, got %v", o1, observers)
+		}
+		if peers := topo.GetPeers(topology.Query{Type: p2p.PeerTypeBidder}); len(peers) != 0 {
+			t.Fatalf("expected no bidders, got %v", peers)
+		}
+
+		// Providers which connect later are announced to the observer.
+		announcer.broadcasts = nil
+		topo.Connected(p2)
+		found := false
+		for _, p := range announcer.broadcasts {
+			if p == o1 {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("expected broadcast to observer, got %v", announcer.broadcasts)
+		}
+
+		topo.Disconnected(o1)
+		if topo.IsConnected(o1.EthAddress) {
+			t.Fatal("observer still connected")
+		}
+	})
+
+	t.Run("capabilities", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+
+		p1 := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeProvider,
+			Capabilities: &p2p.Capabilities{
+				Protocols: []p2p.ProtocolInfo{{Name: "test", Version: "1.2.0"}},
+				Features:  []string{"feature"},
+			},
+		}
+		p2 := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeProvider,
+			Capabilities: &p2p.Capabilities{
+				Protocols: []p2p.ProtocolInfo{{Name: "test", Version: "2.0.0"}},
+			},
+		}
+		// Capabilities of p3 are unknown.
+		p3 := p2p.Peer{
+			EthAddress: common.HexToAddress("0x3"),
+			Type:       p2p.PeerTypeProvider,
+		}
+
+		topo.AddPeers(p1, p2, p3)
+
+		peers := topo.GetPeers(topology.Query{
+			Type:     p2p.PeerTypeProvider,
+			Protocol: p2p.ProtocolInfo{Name: "test", Version: "1.1.0"},
+		})
+		if len(peers) != 2 {
+			t.Fatalf("expected 2 peers, got %d", len(peers))
+		}
+		for _, p := range peers {
+			if p.EthAddress == p2.EthAddress {
+				t.Fatal("peer with incompatible protocol selected")
+			}
+		}
+
+		peers = topo.GetPeers(topology.Query{
+			Type:    p2p.PeerTypeProvider,
+			Feature: "feature",
+		})
+		if len(peers) != 1 || peers[0].EthAddress != p1.EthAddress {
+			t.Fatalf("expected only %s, got %v", p1.EthAddress, peers)
+		}
+	})
+	t.Run("score", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+
+		p1 := p2p.Peer{EthAddress: common.HexToAddress("0x1"), Type: p2p.PeerTypeProvider}
+		p2 := p2p.Peer{EthAddress: common.HexToAddress("0x2"), Type: p2p.PeerTypeProvider}
+		p3 := p2p.Peer{EthAddress: common.HexToAddress("0x3"), Type: p2p.PeerTypeProvider}
+
+		topo.SetScorer(testScorer{
+			p1.EthAddress: -20,
+			p2.EthAddress: 10,
+		})
+		topo.AddPeers(p1, p2, p3)
+
+		peers := topo.GetPeers(topology.Query{
+			Type:   p2p.PeerTypeProvider,
+			SortBy: topology.SortByScore,
+		})
+		if len(peers) != 3 {
+			t.Fatalf("expected 3 peers, got %d", len(peers))
+		}
+		order := []common.Address{p2.EthAddress, p3.EthAddress, p1.EthAddress}
+		for i, p := range peers {
+			if p.EthAddress != order[i] {
+				t.Fatalf("expected %s at position %d, got %s", order[i], i, p.EthAddress)
+			}
+		}
+
+		minScore := float64(0)
+		peers = topo.GetPeers(topology.Query{
+			Type:     p2p.PeerTypeProvider,
+			MinScore: &minScore,
+		})
+		if len(peers) != 2 {
+			t.Fatalf("expected 2 peers, got %d", len(peers))
+		}
+		for _, p := range peers {
+			if p.EthAddress == p1.EthAddress {
+				t.Fatal("peer with low score selected")
+			}
+		}
+	})
+	t.Run("query", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+
+		p1 := p2p.Peer{
+			EthAddress:   common.HexToAddress("0x1"),
+			Type:         p2p.PeerTypeProvider,
+			Capabilities: &p2p.Capabilities{Version: "v1.2.0"},
+		}
+		p2 := p2p.Peer{
+			EthAddress:   common.HexToAddress("0x2"),
+			Type:         p2p.PeerTypeProvider,
+			Capabilities: &p2p.Capabilities{Version: "v1.0.0"},
+		}
+		// The stake and the latency of p3 are unknown.
+		p3 := p2p.Peer{
+			EthAddress: common.HexToAddress("0x3"),
+			Type:       p2p.PeerTypeProvider,
+		}
+
+		topo.SetStakeReader(testStakes{
+			p1.EthAddress: big.NewInt(10),
+			p2.EthAddress: big.NewInt(20),
+		})
+		topo.SetLatencyMeter(testLatency{
+			p1.EthAddress: 50 * time.Millisecond,
+			p2.EthAddress: 200 * time.Millisecond,
+		})
+
+		now := time.Now()
+		topo.SetNow(func() time.Time { return now })
+		topo.AddPeers(p1)
+		now = now.Add(time.Minute)
+		topo.AddPeers(p2, p3)
+		topo.SetTags(p2.EthAddress, []string{"eu", "trusted"})
+		topo.SetTags(p3.EthAddress, []string{"eu"})
+
+		// The stakes are read in the background.
+		start := time.Now()
+		for len(topo.GetPeers(topology.Query{Type: p2p.PeerTypeProvider, MinStake: big.NewInt(0)})) != 2 {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("timed out waiting for stakes")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		for _, tc := range []struct {
+			name  string
+			query topology.Query
+			want  []common.Address
+		}{
+			{
+				name:  "sort by stake",
+				query: topology.Query{SortBy: topology.SortByStake},
+				want:  []common.Address{p2.EthAddress, p1.EthAddress, p3.EthAddress},
+			},
+			{
+				name:  "sort by latency",
+				query: topology.Query{SortBy: topology.SortByLatency},
+				want:  []common.Address{p1.EthAddress, p2.EthAddress, p3.EthAddress},
+			},
+			{
+				name:  "sort by connection age",
+				query: topology.Query{SortBy: topology.SortByConnectionAge, Limit: 1},
+				want:  []common.Address{p1.EthAddress},
+			},
+			{
+				name:  "min stake",
+				query: topology.Query{MinStake: big.NewInt(15)},
+				want:  []common.Address{p2.EthAddress},
+			},
+			{
+				name:  "max latency",
+				query: topology.Query{MaxLatency: 100 * time.Millisecond, SortBy: topology.SortByLatency},
+				want:  []common.Address{p1.EthAddress, p3.EthAddress},
+			},
+			{
+				name:  "min version",
+				query: topology.Query{MinVersion: "1.1.0"},
+				want:  []common.Address{p1.EthAddress},
+			},
+			{
+				name:  "min connection age",
+				query: topology.Query{MinConnectionAge: 30 * time.Second},
+				want:  []common.Address{p1.EthAddress},
+			},
+			{
+				name:  "tags",
+				query: topology.Query{Tags: []string{"eu", "trusted"}},
+				want:  []common.Address{p2.EthAddress},
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				tc.query.Type = p2p.PeerTypeProvider
+				peers := topo.GetPeers(tc.query)
+				if len(peers) != len(tc.want) {
+					t.Fatalf("expected %d peers, got %d", len(tc.want), len(peers))
+				}
+				for i, p := range peers {
+					if p.EthAddress != tc.want[i] {
+						t.Fatalf("expected %s at position %d, got %s", tc.want[i], i, p.EthAddress)
+					}
+				}
+			})
+		}
+
+		details := topo.PeerDetails(topology.Query{Type: p2p.PeerTypeProvider, Tags: []string{"trusted"}})
+		if len(details) != 1 || details[0].Stake.Int64() != 20 || details[0].Latency != 200*time.Millisecond {
+			t.Fatalf("unexpected details %+v", details)
+		}
+	})
+	t.Run("stake after disconnect", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+
+		release := make(chan struct{})
+		read := make(chan struct{})
+		topo.SetStakeReader(stakeReaderFunc(func(context.Context, common.Address) (*big.Int, error) {
+			<-release
+			defer close(read)
+			return big.NewInt(10), nil
+		}))
+
+		p1 := p2p.Peer{EthAddress: common.HexToAddress("0x1"), Type: p2p.PeerTypeProvider}
+
+		// The peer is added without waiting for its stake.
+		topo.AddPeers(p1)
+		if !topo.IsConnected(p1.EthAddress) {
+			t.Fatal("peer not connected")
+		}
+
+		topo.Disconnected(p1)
+		close(release)
+		<-read
+
+		// The stake read after the disconnect doesn't add the peer again.
+		time.Sleep(10 * time.Millisecond)
+		if topo.IsConnected(p1.EthAddress) {
+			t.Fatal("peer still connected")
+		}
+		if len(topo.PeerDetails(topology.Query{Type: p2p.PeerTypeProvider})) != 0 {
+			t.Fatal("expected no providers")
+		}
+	})
+
+	t.Run("add again", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+
+		var (
+			mu    sync.Mutex
+			reads int
+		)
+		topo.SetStakeReader(stakeReaderFunc(func(context.Context, common.Address) (*big.Int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			reads++
+			return big.NewInt(10), nil
+		}))
+
+		p1 := p2p.Peer{EthAddress: common.HexToAddress("0x1"), Type: p2p.PeerTypeProvider}
+		topo.AddPeers(p1, p1)
+		topo.AddPeers(p1)
+
+		start := time.Now()
+		for {
+			details := topo.PeerDetails(topology.Query{Type: p2p.PeerTypeProvider})
+			if len(details) != 1 {
+				t.Fatalf("expected 1 provider, got %d", len(details))
+			}
+			if details[0].Stake != nil {
+				break
+			}
+			if time.Since(start) > time.Second {
+				t.Fatal("timed out waiting for stake")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		// The stake is only read when the peer is added the first time.
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		if reads != 1 {
+			t.Fatalf("expected stake to be read once, got %d", reads)
+		}
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+		dialer := &testDialer{err: errors.New("unreachable")}
+		topo.SetDialer(dialer)
+		topo.SetTargets(topology.Targets{Providers: 1})
+
+		now := time.Now()
+		topo.SetNow(func() time.Time { return now })
+
+		provider := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeProvider,
+			Record:     &p2p.PeerInfo{Underlay: []byte("provider")},
+		}
+		bidder := p2p.Peer{
+			EthAddress: common.HexToAddress("0x2"),
+			Type:       p2p.PeerTypeBidder,
+			Record:     &p2p.PeerInfo{Underlay: []byte("bidder")},
+		}
+		topo.AddPeers(provider, bidder)
+		topo.Disconnected(provider)
+		topo.Disconnected(bidder)
+
+		for _, tc := range []struct {
+			advance time.Duration
+			dials   int
+		}{
+			{advance: 0, dials: 0},
+			{advance: 5 * time.Second, dials: 1},
+			{advance: 5 * time.Second, dials: 1},
+			{advance: 5 * time.Second, dials: 2},
+			{advance: 10 * time.Second, dials: 2},
+			{advance: 10 * time.Second, dials: 3},
+		} {
+			now = now.Add(tc.advance)
+			topo.Maintain()
+			if dialer.count() != tc.dials {
+				t.Fatalf("expected %d dials after %s, got %d", tc.dials, tc.advance, dialer.count())
+			}
+		}
+		if string(dialer.dials[0]) != "provider" {
+			t.Fatalf("expected provider to be redialed, got %s", dialer.dials[0])
+		}
+
+		// A successful redial adds the peer.
+		dialer.succeed(provider)
+		now = now.Add(40 * time.Second)
+		topo.Maintain()
+		if dialer.count() != 4 {
+			t.Fatalf("expected 4 dials, got %d", dialer.count())
+		}
+		if !topo.IsConnected(provider.EthAddress) {
+			t.Fatal("expected redialed provider to be connected")
+		}
+
+		now = now.Add(time.Hour)
+		topo.Maintain()
+		if dialer.count() != 4 {
+			t.Fatalf("expected no dials at target, got %d", dialer.count())
+		}
+	})
+
+	t.Run("maintenance fallback", func(t *testing.T) {
+		topo := topology.New(&testAddressbook{}, newTestLogger(os.Stdout))
+		dialer := &testDialer{err: errors.New("unreachable")}
+		syncer := &testSyncer{}
+		topo.SetDialer(dialer)
+		topo.SetAddressBook(testBook{
+			p2p.PeerTypeProvider: {
+				{EthAddress: common.HexToAddress("0x1"), Underlay: []byte("provider1")},
+				{EthAddress: common.HexToAddress("0x2"), Underlay: []byte("provider2")},
+			},
+			p2p.PeerTypeBidder: {
+				{EthAddress: common.HexToAddress("0x3"), Underlay: []byte("bidder")},
+			},
+		})
+		topo.SetPeerSyncer(syncer)
+		topo.SetTargets(topology.Targets{Providers: 1})
+
+		now := time.Now()
+		topo.SetNow(func() time.Time { return now })
+
+		// Without disconnected peers, the known providers are dialed up to
+		// the number of missing peers.
+		topo.Maintain()
+		if dialer.count() != 1 || string(dialer.dials[0]) != "provider1" {
+			t.Fatalf("expected known provider to be dialed, got %q", dialer.dials)
+		}
+		if syncer.count() != 0 {
+			t.Fatalf("expected no resync, got %d", syncer.count())
+		}
+
+		// The failed provider is redialed with backoff, so the next known
+		// provider is dialed meanwhile.
+		now = now.Add(time.Second)
+		topo.Maintain()
+		if dialer.count() != 2 || string(dialer.dials[1]) != "provider2" {
+			t.Fatalf("expected next known provider to be dialed, got %q", dialer.dials)
+		}
+
+		// With all known providers backing off, the connected peers are asked
+		// for new ones, at most once per interval.
+		now = now.Add(time.Second)
+		topo.Maintain()
+		now = now.Add(time.Second)
+		topo.Maintain()
+		if dialer.count() != 2 {
+			t.Fatalf("expected no dials, got %d", dialer.count())
+		}
+		if syncer.count() != 1 {
+			t.Fatalf("expected 1 resync, got %d", syncer.count())
+		}
+
+		// A successful dial of a known provider adds it.
+		provider := p2p.Peer{
+			EthAddress: common.HexToAddress("0x1"),
+			Type:       p2p.PeerTypeProvider,
+		}
+		dialer.succeed(provider)
+		now = now.Add(time.Minute)
+		topo.Maintain()
+		if !topo.IsConnected(provider.EthAddress) {
+			t.Fatal("expected known provider to be connected")
+		}
+		for _, d := range dialer.dials {
+			if string(d) == "bidder" {
+				t.Fatal("expected bidder without target not to be dialed")
+			}
+		}
 	})
 }
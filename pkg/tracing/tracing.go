@@ -0,0 +1,145 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/util"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const serviceName = "mev-commit"
+
+type Options struct {
+	// Endpoint is the URL of the OTLP gRPC collector, e.g.
+	// http://localhost:4317. The connection is insecure for http URLs.
+	Endpoint string
+	// File is the path of the file to which the spans are appended as JSON.
+	File string
+	// Attributes describe the node in all its spans.
+	Attributes []attribute.KeyValue
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+// New installs the W3C trace context propagator and, if an endpoint or a
+// file is configured, a tracer provider exporting the spans there. Without
+// exporter the trace context is still passed on to the peers. The returned
+// closer flushes the pending spans.
+func New(opts Options) (io.Closer, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if opts.Endpoint == "" && opts.File == "" {
+		return closerFunc(func() error { return nil }), nil
+	}
+
+	var (
+		exporters []sdktrace.SpanExporter
+		closers   []io.Closer
+	)
+	if opts.Endpoint != "" {
+		exp, err := otlptracegrpc.New(
+			context.Background(),
+			otlptracegrpc.WithEndpointURL(opts.Endpoint),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		exporters = append(exporters, exp)
+	}
+	if opts.File != "" {
+		file, err := util.ResolveFilePath(opts.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve trace file path: %w", err)
+		}
+		f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			return nil, errors.Join(err, f.Close())
+		}
+		exporters = append(exporters, exp)
+		closers = append(closers, f)
+	}
+
+	res := resource.NewSchemaless(append(
+		[]attribute.KeyValue{attribute.String("service.name", serviceName)},
+		opts.Attributes...,
+	)...)
+	tpOpts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	for _, exp := range exporters {
+		tpOpts = append(tpOpts, sdktrace.WithBatcher(exp))
+	}
+	tp := sdktrace.NewTracerProvider(tpOpts...)
+	otel.SetTracerProvider(tp)
+
+	return closerFunc(func() error {
+		err := tp.Shutdown(context.Background())
+		for _, c := range closers {
+			err = errors.Join(err, c.Close())
+		}
+		return err
+	}), nil
+}
+
+// EndSpan ends the span and marks it as failed if err is set.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// HeaderCarrier carries the trace context in the header of a p2p stream.
+type HeaderCarrier p2p.Header
+
+func (c HeaderCarrier) Get(key string) string {
+	return c[key].GetStringValue()
+}
+
+func (c HeaderCarrier) Set(key, value string) {
+	c[key] = structpb.NewStringValue(value)
+}
+
+func (c HeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Inject adds the trace context of ctx to the header.
+func Inject(ctx context.Context, hdr p2p.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, HeaderCarrier(hdr))
+}
+
+// Extract returns a copy of ctx with the trace context of the remote peer
+// from the header.
+func Extract(ctx context.Context, hdr p2p.Header) context.Context {
+	if hdr == nil {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, HeaderCarrier(hdr))
+}
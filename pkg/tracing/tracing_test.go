@@ -0,0 +1,87 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/primevprotocol/mev-commit/pkg/p2p"
+	"github.com/primevprotocol/mev-commit/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTracing(t *testing.T) {
+	t.Run("header", func(t *testing.T) {
+		if _, err := tracing.New(tracing.Options{}); err != nil {
+			t.Fatal(err)
+		}
+
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+		hdr := p2p.Header{}
+		tracing.Inject(ctx, hdr)
+		if len(hdr) == 0 {
+			t.Fatal("expected trace context in header")
+		}
+
+		remote := trace.SpanContextFromContext(tracing.Extract(context.Background(), hdr))
+		if !remote.IsRemote() || remote.TraceID() != sc.TraceID() || remote.SpanID() != sc.SpanID() {
+			t.Fatalf("expected span context %v, got %v", sc, remote)
+		}
+
+		if got := trace.SpanContextFromContext(tracing.Extract(context.Background(), nil)); got.IsValid() {
+			t.Fatalf("expected no span context, got %v", got)
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "traces.json")
+		closer, err := tracing.New(tracing.Options{File: file})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, span := otel.Tracer("test").Start(context.Background(), "test-span")
+		span.End()
+
+		if err := closer.Close(); err != nil {
+			t.Fatal(err)
+		}
+		otel.SetTracerProvider(noop.NewTracerProvider())
+
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(buf, []byte("test-span")) {
+			t.Fatalf("expected span in file, got %s", buf)
+		}
+	})
+
+	t.Run("file in home directory", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		closer, err := tracing.New(tracing.Options{File: "~/traces.json"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := closer.Close(); err != nil {
+			t.Fatal(err)
+		}
+		otel.SetTracerProvider(noop.NewTracerProvider())
+
+		if _, err := os.Stat(filepath.Join(home, "traces.json")); err != nil {
+			t.Fatalf("expected trace file in home directory: %v", err)
+		}
+	})
+}
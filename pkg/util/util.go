@@ -4,17 +4,28 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
-	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 )
 
-func PadKeyTo32Bytes(key *big.Int) []byte {
-	keyBytes := key.Bytes()
-	if len(keyBytes) < 32 {
-		padding := make([]byte, 32-len(keyBytes))
-		keyBytes = append(padding, keyBytes...)
+// ResolveFilePath expands a leading "~" in path to the home directory of the
+// current user.
+func ResolveFilePath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("path is empty")
 	}
-	return keyBytes
+
+	if strings.HasPrefix(path, "~") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+
+		return filepath.Join(home, path[1:]), nil
+	}
+
+	return path, nil
 }
 
 func NewTestLogger(w io.Writer) *slog.Logger {